@@ -2,25 +2,28 @@ package api
 
 import (
 	"errors"
-	"fmt"
 	"log/slog"
-	"reflect"
 	"slices"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/charmbracelet/log"
-	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/prgrs/clickup/pkg/cache"
 	"github.com/prgrs/clickup/pkg/clickup"
+	"github.com/prgrs/clickup/pkg/notifier"
 )
 
 const (
 	CacheNamespaceTeams          cache.Namespace = "teams"
 	CacheNamespaceSpaces         cache.Namespace = "spaces"
+	CacheNamespaceSpaceTags      cache.Namespace = "space-tags"
 	CacheNamespaceFolders        cache.Namespace = "folders"
 	CacheNamespaceLists          cache.Namespace = "lists"
 	CacheNamespaceListsFolder    cache.Namespace = "lists-folder"
+	CacheNamespaceListsSpace     cache.Namespace = "lists-space"
 	CacheNamespaceViewsWorkspace cache.Namespace = "views-workspace"
 	CacheNamespaceViewsSpace     cache.Namespace = "views-space"
 	CacheNamespaceViewsFolder    cache.Namespace = "views-folder"
@@ -28,6 +31,8 @@ const (
 	CacheNamespaceTasks          cache.Namespace = "tasks"
 	CacheNamespaceTasksList      cache.Namespace = "tasks-list"
 	CacheNamespaceTasksView      cache.Namespace = "tasks-view"
+	CacheNamespaceTasksAssigned  cache.Namespace = "tasks-assigned"
+	CacheNamespaceUser           cache.Namespace = "user"
 
 	SyncInterval = 1000
 	// SyncInterval = 1
@@ -36,6 +41,12 @@ const (
 type Api struct {
 	Clickup   *clickup.Client
 	Cache     *cache.Cache
+	Inbox     *Inbox
+	History   *History
+	notifier  notifier.Notifier
+	syncState *syncState
+	inflight  singleflight.Group
+	tasksLock sync.Mutex
 	logger    *log.Logger
 	closeChan chan struct{}
 	interval  time.Duration
@@ -54,6 +65,10 @@ func NewApi(logger *log.Logger, cache *cache.Cache, token string) *Api {
 		Clickup:   clickup,
 		logger:    log,
 		Cache:     cache,
+		Inbox:     NewInbox(),
+		History:   NewHistory(),
+		notifier:  notifier.Nop{},
+		syncState: newSyncState(),
 		interval:  SyncInterval * time.Second,
 		closeChan: make(chan struct{}),
 	}
@@ -84,6 +99,12 @@ func (m *Api) Close() {
 	m.closeChan <- struct{}{}
 }
 
+// SetNotifier sets where notifications about tasks changed while syncing are
+// sent besides the inbox.
+func (m *Api) SetNotifier(n notifier.Notifier) {
+	m.notifier = n
+}
+
 func (m *Api) GetSpaces(teamId string) ([]clickup.Space, error) {
 	return m.getSpaces(true, teamId)
 }
@@ -95,16 +116,11 @@ func (m *Api) SyncSpaces(teamId string) ([]clickup.Space, error) {
 func (m *Api) getSpaces(cached bool, teamId string) ([]clickup.Space, error) {
 	m.logger.Debug("Getting spaces for a team", "teamId", teamId)
 
-	var data []clickup.Space
 	cacheNamespace := CacheNamespaceSpaces
 	key := teamId
-	fallback := func() (interface{}, error) { return m.Clickup.GetSpacesFromTeam(key) }
-
-	if err := m.get(cacheNamespace, cache.Key(key), &data, fallback, cached); err != nil {
-		return nil, err
-	}
+	fallback := func() ([]clickup.Space, error) { return m.Clickup.GetSpacesFromTeam(key) }
 
-	return data, nil
+	return get(m, cacheNamespace, key, fallback, cached)
 }
 
 // Alias for GetTeams since they are the same thing
@@ -123,16 +139,11 @@ func (m *Api) SyncTeams() ([]clickup.Team, error) {
 func (m *Api) getTeams(cached bool) ([]clickup.Team, error) {
 	m.logger.Debug("Getting Authorized Teams (Workspaces)")
 
-	var data []clickup.Team
 	cacheNamespace := CacheNamespaceTeams
 	key := "teams"
-	fallback := func() (interface{}, error) { return m.Clickup.GetTeams() }
+	fallback := func() ([]clickup.Team, error) { return m.Clickup.GetTeams() }
 
-	if err := m.get(cacheNamespace, cache.Key(key), &data, fallback, cached); err != nil {
-		return nil, err
-	}
-
-	return data, nil
+	return get(m, cacheNamespace, key, fallback, cached)
 }
 
 func (m *Api) GetFolders(spaceId string) ([]clickup.Folder, error) {
@@ -146,19 +157,20 @@ func (m *Api) SyncFolders(spaceId string) ([]clickup.Folder, error) {
 func (m *Api) getFolders(cached bool, spaceId string) ([]clickup.Folder, error) {
 	m.logger.Debug("Getting folders for a space", "space", spaceId)
 
-	var data []clickup.Folder
 	cacheNamespace := CacheNamespaceFolders
 	key := spaceId
-	fallback := func() (interface{}, error) { return m.Clickup.GetFolders(key) }
+	fallback := func() ([]clickup.Folder, error) { return m.Clickup.GetFolders(key) }
 
-	if err := m.get(cacheNamespace, cache.Key(key), &data, fallback, cached); err != nil {
-		return nil, err
-	}
-
-	return data, nil
+	return get(m, cacheNamespace, key, fallback, cached)
 }
 
+// GetLists returns lists of the folder. For the id of a pseudo folder made by
+// FolderlessFolder it returns folderless lists of its space.
 func (m *Api) GetLists(folderId string) ([]clickup.List, error) {
+	if spaceId, ok := ParseFolderlessFolderId(folderId); ok {
+		return m.GetFolderlessLists(spaceId)
+	}
+
 	return m.getListsFromFolder(true, folderId)
 }
 
@@ -169,16 +181,29 @@ func (m *Api) SyncLists(folderId string) ([]clickup.List, error) {
 func (m *Api) getListsFromFolder(cached bool, folderId string) ([]clickup.List, error) {
 	m.logger.Debug("Getting lists for a folder", "folderId", folderId)
 
-	var data []clickup.List
 	cacheNamespace := CacheNamespaceListsFolder
 	key := folderId
-	fallback := func() (interface{}, error) { return m.Clickup.GetListsFromFolder(key) }
+	fallback := func() ([]clickup.List, error) { return m.Clickup.GetListsFromFolder(key) }
 
-	if err := m.get(cacheNamespace, cache.Key(key), &data, fallback, cached); err != nil {
-		return nil, err
-	}
+	return get(m, cacheNamespace, key, fallback, cached)
+}
+
+func (m *Api) GetFolderlessLists(spaceId string) ([]clickup.List, error) {
+	return m.getFolderlessLists(true, spaceId)
+}
+
+func (m *Api) SyncFolderlessLists(spaceId string) ([]clickup.List, error) {
+	return m.getFolderlessLists(false, spaceId)
+}
+
+func (m *Api) getFolderlessLists(cached bool, spaceId string) ([]clickup.List, error) {
+	m.logger.Debug("Getting folderless lists for a space", "spaceId", spaceId)
 
-	return data, nil
+	cacheNamespace := CacheNamespaceListsSpace
+	key := spaceId
+	fallback := func() ([]clickup.List, error) { return m.Clickup.GetFolderlessLists(key) }
+
+	return get(m, cacheNamespace, key, fallback, cached)
 }
 
 func (m *Api) GetTask(taskId string) (clickup.Task, error) {
@@ -192,16 +217,11 @@ func (m *Api) SyncTask(taskId string) (clickup.Task, error) {
 func (m *Api) getTask(cached bool, taskId string) (clickup.Task, error) {
 	m.logger.Debug("Getting a task", "taskId", taskId)
 
-	var data clickup.Task
 	cacheNamespace := CacheNamespaceTasks
 	key := taskId
-	fallback := func() (interface{}, error) { return m.Clickup.GetTask(key) }
-
-	if err := m.get(cacheNamespace, cache.Key(key), &data, fallback, cached); err != nil {
-		return clickup.Task{}, err
-	}
+	fallback := func() (clickup.Task, error) { return m.Clickup.GetTask(key) }
 
-	return data, nil
+	return get(m, cacheNamespace, key, fallback, cached)
 }
 
 func (m *Api) GetTasksFromList(listId string) ([]clickup.Task, error) {
@@ -215,16 +235,20 @@ func (m *Api) SyncTasksFromList(listId string) ([]clickup.Task, error) {
 func (m *Api) getTasksFromList(cached bool, listId string) ([]clickup.Task, error) {
 	m.logger.Debug("Getting tasks for a list", "listId", listId)
 
-	var data []clickup.Task
 	cacheNamespace := CacheNamespaceTasksList
 	key := listId
-	fallback := func() (interface{}, error) { return m.Clickup.GetTasksFromList(key) }
+	fallback := func() ([]clickup.Task, error) {
+		ts := time.Now()
+		tasks, err := m.Clickup.GetTasksFromList(key)
+		if err != nil {
+			return nil, err
+		}
+		m.syncState.setFullySynced(cacheNamespace, cache.Key(key), ts)
 
-	if err := m.get(cacheNamespace, cache.Key(key), &data, fallback, cached); err != nil {
-		return nil, err
+		return tasks, nil
 	}
 
-	return data, nil
+	return get(m, cacheNamespace, key, fallback, cached)
 }
 
 func (m *Api) GetTasksFromView(viewId string) ([]clickup.Task, error) {
@@ -238,16 +262,55 @@ func (m *Api) SyncTasksFromView(viewId string) ([]clickup.Task, error) {
 func (m *Api) getTasksFromView(cached bool, viewId string) ([]clickup.Task, error) {
 	m.logger.Debug("Getting tasks for a view", "viewId", viewId)
 
-	var data []clickup.Task
 	cacheNamespace := CacheNamespaceTasksView
 	key := viewId
-	fallback := func() (interface{}, error) { return m.Clickup.GetTasksFromView(key) }
+	fallback := func() ([]clickup.Task, error) { return m.Clickup.GetTasksFromView(key) }
+
+	return get(m, cacheNamespace, key, fallback, cached)
+}
+
+func (m *Api) GetAuthorizedUser() (clickup.User, error) {
+	return m.getAuthorizedUser(true)
+}
+
+func (m *Api) SyncAuthorizedUser() (clickup.User, error) {
+	return m.getAuthorizedUser(false)
+}
+
+func (m *Api) getAuthorizedUser(cached bool) (clickup.User, error) {
+	m.logger.Debug("Getting authorized user")
+
+	cacheNamespace := CacheNamespaceUser
+	key := "user"
+	fallback := func() (clickup.User, error) { return m.Clickup.GetAuthorizedUser() }
 
-	if err := m.get(cacheNamespace, cache.Key(key), &data, fallback, cached); err != nil {
+	return get(m, cacheNamespace, key, fallback, cached)
+}
+
+// GetMyTasks returns tasks of the team assigned to the authorized user
+func (m *Api) GetMyTasks(teamId string) ([]clickup.Task, error) {
+	return m.getMyTasks(true, teamId)
+}
+
+func (m *Api) SyncMyTasks(teamId string) ([]clickup.Task, error) {
+	return m.getMyTasks(false, teamId)
+}
+
+func (m *Api) getMyTasks(cached bool, teamId string) ([]clickup.Task, error) {
+	m.logger.Debug("Getting tasks assigned to the authorized user", "teamId", teamId)
+
+	user, err := m.GetAuthorizedUser()
+	if err != nil {
 		return nil, err
 	}
 
-	return data, nil
+	cacheNamespace := CacheNamespaceTasksAssigned
+	key := teamId
+	fallback := func() ([]clickup.Task, error) {
+		return m.Clickup.GetTasksAssignedTo(key, strconv.Itoa(user.Id))
+	}
+
+	return get(m, cacheNamespace, key, fallback, cached)
 }
 
 func (m *Api) GetViewsFromFolder(folderId string) ([]clickup.View, error) {
@@ -261,10 +324,9 @@ func (m *Api) syncViewsFromFolder(folderId string) ([]clickup.View, error) {
 func (m *Api) getViewsFromFolder(cached bool, folderId string) ([]clickup.View, error) {
 	m.logger.Debug("Getting views for folder", "folder", folderId)
 
-	var data []clickup.View
 	cacheNamespace := CacheNamespaceViewsFolder
 	key := folderId
-	fallback := func() (interface{}, error) {
+	fallback := func() ([]clickup.View, error) {
 		v, err := m.Clickup.GetViewsFromFolder(key)
 		if err != nil {
 			return nil, err
@@ -273,11 +335,7 @@ func (m *Api) getViewsFromFolder(cached bool, folderId string) ([]clickup.View,
 		return filterViews(v, []clickup.ViewType{clickup.ViewTypeList}), nil
 	}
 
-	if err := m.get(cacheNamespace, cache.Key(key), &data, fallback, cached); err != nil {
-		return nil, err
-	}
-
-	return data, nil
+	return get(m, cacheNamespace, key, fallback, cached)
 }
 
 func (m *Api) GetViewsFromList(listId string) ([]clickup.View, error) {
@@ -291,10 +349,9 @@ func (m *Api) syncViewsFromList(listId string) ([]clickup.View, error) {
 func (m *Api) getViewsFromList(cached bool, listId string) ([]clickup.View, error) {
 	m.logger.Debug("Getting views for list", "listId", listId)
 
-	var data []clickup.View
 	cacheNamespace := CacheNamespaceViewsList
 	key := listId
-	fallback := func() (interface{}, error) {
+	fallback := func() ([]clickup.View, error) {
 		v, err := m.Clickup.GetViewsFromList(key)
 		if err != nil {
 			return nil, err
@@ -303,11 +360,7 @@ func (m *Api) getViewsFromList(cached bool, listId string) ([]clickup.View, erro
 		return filterViews(v, []clickup.ViewType{clickup.ViewTypeList}), nil
 	}
 
-	if err := m.get(cacheNamespace, cache.Key(key), &data, fallback, cached); err != nil {
-		return nil, err
-	}
-
-	return data, nil
+	return get(m, cacheNamespace, key, fallback, cached)
 }
 
 func (m *Api) GetViewsFromSpace(spaceId string) ([]clickup.View, error) {
@@ -321,10 +374,9 @@ func (m *Api) syncViewsFromSpace(spaceId string) ([]clickup.View, error) {
 func (m *Api) getViewsFromSpace(cached bool, spaceId string) ([]clickup.View, error) {
 	m.logger.Info("Getting views for space", "spaceId", spaceId)
 
-	var data []clickup.View
 	cacheNamespace := CacheNamespaceViewsSpace
 	key := spaceId
-	fallback := func() (interface{}, error) {
+	fallback := func() ([]clickup.View, error) {
 		v, err := m.Clickup.GetViewsFromSpace(key)
 		if err != nil {
 			return nil, err
@@ -333,11 +385,7 @@ func (m *Api) getViewsFromSpace(cached bool, spaceId string) ([]clickup.View, er
 		return filterViews(v, []clickup.ViewType{clickup.ViewTypeList}), nil
 	}
 
-	if err := m.get(cacheNamespace, cache.Key(key), &data, fallback, cached); err != nil {
-		return nil, err
-	}
-
-	return data, nil
+	return get(m, cacheNamespace, key, fallback, cached)
 }
 
 func (m *Api) GetViewsFromWorkspace(workspaceId string) ([]clickup.View, error) {
@@ -351,10 +399,9 @@ func (m *Api) syncViewsFromWorkspace(workspaceId string) ([]clickup.View, error)
 func (m *Api) getViewsFromWorkspace(cached bool, workspaceId string) ([]clickup.View, error) {
 	m.logger.Debug("Getting views for workspace", "workspaceId", workspaceId)
 
-	var data []clickup.View
 	cacheNamespace := CacheNamespaceViewsWorkspace
 	key := workspaceId
-	fallback := func() (interface{}, error) {
+	fallback := func() ([]clickup.View, error) {
 		v, err := m.Clickup.GetViewsFromWorkspace(key)
 		if err != nil {
 			return nil, err
@@ -363,11 +410,7 @@ func (m *Api) getViewsFromWorkspace(cached bool, workspaceId string) ([]clickup.
 		return filterViews(v, []clickup.ViewType{clickup.ViewTypeList}), nil
 	}
 
-	if err := m.get(cacheNamespace, cache.Key(key), &data, fallback, cached); err != nil {
-		return nil, err
-	}
-
-	return data, nil
+	return get(m, cacheNamespace, key, fallback, cached)
 }
 
 func (m *Api) GetList(listId string) (clickup.List, error) {
@@ -381,78 +424,90 @@ func (m *Api) SyncList(listId string) (clickup.List, error) {
 func (m *Api) getList(cached bool, listId string) (clickup.List, error) {
 	m.logger.Debug("Getting a list", "listId", listId)
 
-	var data clickup.List
 	cacheNamespace := CacheNamespaceLists
 	key := listId
-	fallback := func() (interface{}, error) { return m.Clickup.GetList(key) }
-
-	if err := m.get(cacheNamespace, cache.Key(key), &data, fallback, cached); err != nil {
-		return clickup.List{}, err
-	}
-
-	return data, nil
-}
-
-func (m *Api) Sync() error {
-	m.logger.Debug("Sync API")
-
-	entries := m.Cache.GetEntries()
-	errgroup := new(errgroup.Group)
-
-	for _, entry := range entries {
-		func(entry cache.Entry) {
-			errgroup.Go(func() error {
-				if !entry.Stale {
-					return nil
-				}
-
-				m.logger.Debug("Invalidating cache", "entry", entry.Id())
-
-				var err error
-				key := entry.Key.String()
-
-				switch entry.Namespace {
-				case CacheNamespaceTeams:
-					_, err = m.SyncTeams()
-				case CacheNamespaceSpaces:
-					_, err = m.SyncSpaces(key)
-				case CacheNamespaceFolders:
-					_, err = m.SyncFolders(key)
-				case CacheNamespaceLists:
-					_, err = m.SyncList(key)
-				case CacheNamespaceListsFolder:
-					_, err = m.SyncLists(key)
-				case CacheNamespaceViewsWorkspace:
-					_, err = m.syncViewsFromWorkspace(key)
-				case CacheNamespaceViewsSpace:
-					_, err = m.syncViewsFromSpace(key)
-				case CacheNamespaceViewsFolder:
-					_, err = m.syncViewsFromFolder(key)
-				case CacheNamespaceViewsList:
-					_, err = m.syncViewsFromList(key)
-				case CacheNamespaceTasksList:
-					_, err = m.SyncTasksFromList(key)
-				case CacheNamespaceTasksView:
-					_, err = m.SyncTasksFromView(key)
-				case CacheNamespaceTasks:
-					_, err = m.SyncTask(key)
-				default:
-					m.logger.Warn("Removing cache entry due to invalid namespace", "entry", entry.Id(), "namespace", entry.Namespace)
-				}
-
-				if err != nil {
-					return fmt.Errorf("failed to invalidate task cache %e", err)
-				}
-				return nil
-			})
-		}(entry)
-	}
-
-	if err := errgroup.Wait(); err != nil {
-		m.logger.Error(err)
+	fallback := func() (clickup.List, error) { return m.Clickup.GetList(key) }
+
+	return get(m, cacheNamespace, key, fallback, cached)
+}
+
+// cachedTasks returns tasks stored in the entry if its namespace holds tasks.
+// Reading them does not count as an access of the entry.
+func (m *Api) cachedTasks(entry cache.Entry) []clickup.Task {
+	var (
+		tasks []clickup.Task
+		err   error
+	)
+
+	switch {
+	case entry.Namespace == CacheNamespaceTasks:
+		var task clickup.Task
+		if task, err = cache.NewTyped[clickup.Task](m.Cache, entry.Namespace).Peek(entry.Key); err == nil {
+			tasks = []clickup.Task{task}
+		}
+	case isTasksNamespace(entry.Namespace):
+		tasks, err = cache.NewTyped[[]clickup.Task](m.Cache, entry.Namespace).Peek(entry.Key)
 	}
 
-	return nil
+	if errors.Is(err, cache.ErrKeyNotFoundInNamespace) {
+		return nil
+	}
+
+	if err != nil {
+		m.logger.Error("Failed to read cached tasks", "entry", entry.Id(), "error", err)
+		return nil
+	}
+
+	return tasks
+}
+
+// isTasksNamespace reports whether entries of the namespace hold lists of tasks.
+func isTasksNamespace(namespace cache.Namespace) bool {
+	switch namespace {
+	case CacheNamespaceTasksList, CacheNamespaceTasksListArchived, CacheNamespaceTasksView, CacheNamespaceTasksAssigned:
+		return true
+	default:
+		return false
+	}
+}
+
+// recordChanges adds a notification to the inbox for every task which fields
+// changed since the last sync. New and deleted tasks are not reported.
+func (m *Api) recordChanges(old []clickup.Task, new []clickup.Task) {
+	prev := make(map[string]clickup.Task, len(old))
+	for _, t := range old {
+		prev[t.Id] = t
+	}
+
+	for _, t := range new {
+		o, ok := prev[t.Id]
+		if !ok || o.Id == "" {
+			continue
+		}
+
+		changes := diffTasks(o, t)
+		if len(changes) == 0 {
+			continue
+		}
+
+		n := Notification{
+			Id:       t.Id + "@" + t.DateUpdated,
+			TaskId:   t.Id,
+			TaskName: t.Name,
+			Url:      t.Url,
+			Changes:  changes,
+			Ts:       time.Now(),
+		}
+
+		if !m.Inbox.Add(n) {
+			continue
+		}
+
+		m.logger.Debug("Task changed", "taskId", t.Id, "changes", len(changes))
+		if err := m.notifier.Notify(t.Name, n.Body()); err != nil {
+			m.logger.Error("Failed to send notification", "error", err)
+		}
+	}
 }
 
 func filterViews(views []clickup.View, filters []clickup.ViewType) []clickup.View {
@@ -467,74 +522,186 @@ func filterViews(views []clickup.View, filters []clickup.ViewType) []clickup.Vie
 }
 
 func (m *Api) CheckIfCached(namespace cache.Namespace, key string) bool {
-	ok, _ := m.getFromCache(namespace, cache.Key(key), nil)
-	return ok
+	return m.Cache.Has(namespace, cache.Key(key))
 }
 
-func (m *Api) getFromCache(namespace cache.Namespace, key cache.Key, v interface{}) (bool, error) {
-	err := m.Cache.Get(namespace, key, v)
-	if err == nil {
-		return true, nil
+// invalidateKey drops the cache entry ignoring ones which are not cached.
+func (m *Api) invalidateKey(namespace cache.Namespace, key cache.Key) error {
+	if key == "" {
+		return nil
 	}
 
+	err := m.Cache.InvalidateKey(namespace, key)
 	if errors.Is(err, cache.ErrKeyNotFoundInNamespace) {
-		return false, nil
+		return nil
 	}
 
-	return true, err
+	return err
 }
 
-func (m *Api) get(cacheNamespace cache.Namespace, cacheKey cache.Key, data interface{}, fallback func() (interface{}, error), cache bool) error {
-	m.logger.Debug("Getting resources", "namespace", cacheNamespace, "id", cacheKey)
+// get returns the value cached under the key or fetches it with the fallback
+// when it is missing or cached is false. Concurrent fetches of the same key
+// share a single request.
+func get[T any](m *Api, cacheNamespace cache.Namespace, key string, fallback func() (T, error), cached bool) (T, error) {
+	m.logger.Debug("Getting resources", "namespace", cacheNamespace, "id", key)
 
-	if cache {
-		ok, err := m.getFromCache(cacheNamespace, cacheKey, data)
-		if err != nil {
-			return err
+	c := cache.NewTyped[T](m.Cache, cacheNamespace)
+	if cached {
+		v, err := c.Get(cache.Key(key))
+		if err == nil {
+			return v, nil
 		}
-		if ok {
-			return nil
+
+		if !errors.Is(err, cache.ErrKeyNotFoundInNamespace) {
+			return v, err
 		}
 	}
 
-	m.logger.Debug("Fetching resources from API", "namespace", cacheNamespace, "id", cacheKey)
-	newData, err := fallback()
+	v, err, shared := m.inflight.Do(string(cacheNamespace)+"/"+key, func() (interface{}, error) {
+		m.logger.Debug("Fetching resources from API", "namespace", cacheNamespace, "id", key)
+		return c.Fetch(cache.Key(key), fallback)
+	})
+	if shared {
+		m.logger.Debug("Shared in-flight request", "namespace", cacheNamespace, "id", key)
+	}
+
 	if err != nil {
-		return err
+		var zero T
+		return zero, err
 	}
-	m.Cache.Set(cacheNamespace, cacheKey, newData)
 
-	// Use reflection to set the value of the data
-	val := reflect.ValueOf(data)
-	if val.Kind() != reflect.Ptr || val.IsNil() {
-		return fmt.Errorf("data must be a non-nil pointer")
+	return v.(T), nil
+}
+
+// UpdateTask updates the fields of the task with values of its copy. Without
+// fields the ones differing from the cached task are updated. Assignees and
+// tags are compared to the cached task so only the added and removed ones are
+// sent. The edit is recorded in History.
+func (m *Api) UpdateTask(task clickup.Task, fields ...clickup.TaskField) (clickup.Task, error) {
+	m.logger.Debug("Updating a task", "taskId", task.Id, "fields", fields)
+
+	old, err := m.GetTask(task.Id)
+	if err != nil {
+		return clickup.Task{}, err
 	}
 
-	// Ensure newData is assignable to data
-	val = val.Elem()
-	newVal := reflect.ValueOf(newData)
-	if !newVal.Type().AssignableTo(val.Type()) {
-		return fmt.Errorf("cannot assign type %T to type %T", newData, data)
+	if len(fields) == 0 {
+		fields = clickup.ChangedFields(old, task)
 	}
 
-	val.Set(newVal)
+	if len(fields) == 0 {
+		return old, nil
+	}
 
-	return nil
+	t, err := m.updateTask(old, task, fields)
+	if err != nil {
+		return clickup.Task{}, err
+	}
+
+	m.History.record(revision{Applied: t, Revert: old, Fields: fields})
+
+	return t, nil
 }
 
-func (m *Api) UpdateTask(task clickup.Task) (clickup.Task, error) {
-	r := clickup.RequestPutTask{
-		Id:          task.Id,
-		Name:        task.Name,
-		Description: task.Description,
-		Status:      task.Status.Status,
-		Points:      task.Points,
+func (m *Api) updateTask(old clickup.Task, task clickup.Task, fields []clickup.TaskField) (clickup.Task, error) {
+	patch := clickup.NewTaskPatch(task, fields...)
+	if patch.Has(clickup.TaskFieldAssignees) {
+		patch.Assignees = diffAssignees(old.Assignees, task.Assignees)
+	}
+
+	// tags and the list are the only fields not sent in the body
+	if slices.ContainsFunc(fields, func(f clickup.TaskField) bool {
+		return f != clickup.TaskFieldTags && f != clickup.TaskFieldList
+	}) {
+		if _, err := m.Clickup.PatchTask(task.Id, patch); err != nil {
+			return clickup.Task{}, err
+		}
+	}
+
+	if patch.Has(clickup.TaskFieldTags) {
+		if err := m.updateTaskTags(old, task); err != nil {
+			return clickup.Task{}, err
+		}
 	}
 
-	t, err := m.Clickup.UpdateTask(r)
+	if patch.Has(clickup.TaskFieldArchived) && old.Archived != task.Archived {
+		if err := m.taskArchivedChanged(old); err != nil {
+			return clickup.Task{}, err
+		}
+	}
+
+	moved := patch.Has(clickup.TaskFieldList) && old.List.Id != task.List.Id
+	if moved {
+		if err := m.moveTask(old, task.List.Id); err != nil {
+			return clickup.Task{}, err
+		}
+	}
+
+	t, err := m.syncChangedTask(task.Id)
 	if err != nil {
 		return clickup.Task{}, err
 	}
 
-	return m.SyncTask(t.Id)
+	if moved {
+		m.moveCachedTask(t, old.List.Id)
+	}
+
+	return t, nil
+}
+
+// syncChangedTask fetches the task changed through other endpoints than the
+// task one and updates it in cached lists of tasks.
+func (m *Api) syncChangedTask(taskId string) (clickup.Task, error) {
+	t, err := m.SyncTask(taskId)
+	if err != nil {
+		return clickup.Task{}, err
+	}
+
+	m.replaceCachedTask(t, false)
+
+	return t, nil
+}
+
+func (m *Api) updateTaskTags(old clickup.Task, task clickup.Task) error {
+	has := func(tags []clickup.TaskTag, name string) bool {
+		return slices.ContainsFunc(tags, func(t clickup.TaskTag) bool { return t.Name == name })
+	}
+
+	for _, tag := range task.Tags {
+		if !has(old.Tags, tag.Name) {
+			if err := m.Clickup.AddTaskTag(task.Id, tag.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, tag := range old.Tags {
+		if !has(task.Tags, tag.Name) {
+			if err := m.Clickup.RemoveTaskTag(task.Id, tag.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func diffAssignees(old []clickup.Assignee, new []clickup.Assignee) clickup.Assignees {
+	has := func(assignees []clickup.Assignee, id uint) bool {
+		return slices.ContainsFunc(assignees, func(a clickup.Assignee) bool { return a.Id == id })
+	}
+
+	r := clickup.Assignees{}
+	for _, a := range new {
+		if !has(old, a.Id) {
+			r.Add = append(r.Add, int(a.Id))
+		}
+	}
+	for _, a := range old {
+		if !has(new, a.Id) {
+			r.Rem = append(r.Rem, int(a.Id))
+		}
+	}
+
+	return r
 }
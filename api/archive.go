@@ -0,0 +1,113 @@
+package api
+
+import (
+	"errors"
+
+	"github.com/prgrs/clickup/pkg/cache"
+	"github.com/prgrs/clickup/pkg/clickup"
+)
+
+// Archived resources are requested separately from the active ones and kept
+// in their own namespaces, so views that do not show archived items are not
+// affected.
+const (
+	CacheNamespaceFoldersArchived     cache.Namespace = "folders-archived"
+	CacheNamespaceListsFolderArchived cache.Namespace = "lists-folder-archived"
+	CacheNamespaceListsSpaceArchived  cache.Namespace = "lists-space-archived"
+	CacheNamespaceTasksListArchived   cache.Namespace = "tasks-list-archived"
+)
+
+func (m *Api) GetArchivedFolders(spaceId string) ([]clickup.Folder, error) {
+	return m.getArchivedFolders(true, spaceId)
+}
+
+func (m *Api) SyncArchivedFolders(spaceId string) ([]clickup.Folder, error) {
+	return m.getArchivedFolders(false, spaceId)
+}
+
+func (m *Api) getArchivedFolders(cached bool, spaceId string) ([]clickup.Folder, error) {
+	m.logger.Debug("Getting archived folders for a space", "spaceId", spaceId)
+
+	cacheNamespace := CacheNamespaceFoldersArchived
+	key := spaceId
+	fallback := func() ([]clickup.Folder, error) { return m.Clickup.GetArchivedFolders(key) }
+
+	return get(m, cacheNamespace, key, fallback, cached)
+}
+
+// GetArchivedLists returns archived lists of the folder or, for the id of a
+// pseudo folder made by FolderlessFolder, archived folderless lists of its
+// space.
+func (m *Api) GetArchivedLists(folderId string) ([]clickup.List, error) {
+	if spaceId, ok := ParseFolderlessFolderId(folderId); ok {
+		return m.getArchivedFolderlessLists(true, spaceId)
+	}
+
+	return m.getArchivedListsFromFolder(true, folderId)
+}
+
+func (m *Api) getArchivedListsFromFolder(cached bool, folderId string) ([]clickup.List, error) {
+	m.logger.Debug("Getting archived lists for a folder", "folderId", folderId)
+
+	cacheNamespace := CacheNamespaceListsFolderArchived
+	key := folderId
+	fallback := func() ([]clickup.List, error) { return m.Clickup.GetArchivedListsFromFolder(key) }
+
+	return get(m, cacheNamespace, key, fallback, cached)
+}
+
+func (m *Api) getArchivedFolderlessLists(cached bool, spaceId string) ([]clickup.List, error) {
+	m.logger.Debug("Getting archived folderless lists for a space", "spaceId", spaceId)
+
+	cacheNamespace := CacheNamespaceListsSpaceArchived
+	key := spaceId
+	fallback := func() ([]clickup.List, error) { return m.Clickup.GetArchivedFolderlessLists(key) }
+
+	return get(m, cacheNamespace, key, fallback, cached)
+}
+
+func (m *Api) GetArchivedTasksFromList(listId string) ([]clickup.Task, error) {
+	return m.getArchivedTasksFromList(true, listId)
+}
+
+func (m *Api) SyncArchivedTasksFromList(listId string) ([]clickup.Task, error) {
+	return m.getArchivedTasksFromList(false, listId)
+}
+
+func (m *Api) getArchivedTasksFromList(cached bool, listId string) ([]clickup.Task, error) {
+	m.logger.Debug("Getting archived tasks for a list", "listId", listId)
+
+	cacheNamespace := CacheNamespaceTasksListArchived
+	key := listId
+	fallback := func() ([]clickup.Task, error) { return m.Clickup.GetArchivedTasksFromList(key) }
+
+	return get(m, cacheNamespace, key, fallback, cached)
+}
+
+// taskArchivedChanged removes the task from all cached lists of tasks and
+// drops cached tasks of its list to be fetched again on the next access.
+func (m *Api) taskArchivedChanged(task clickup.Task) error {
+	m.removeCachedTask(task.Id)
+
+	listId := cache.Key(task.List.Id)
+	return errors.Join(
+		m.invalidateKey(CacheNamespaceTasksList, listId),
+		m.invalidateKey(CacheNamespaceTasksListArchived, listId),
+	)
+}
+
+// SetListArchived archives or unarchives the list and drops cached lists of
+// its folder or space.
+func (m *Api) SetListArchived(list clickup.List, archived bool) (clickup.List, error) {
+	m.logger.Debug("Setting list archived", "listId", list.Id, "archived", archived)
+
+	if _, err := m.Clickup.SetListArchived(list.Id, archived); err != nil {
+		return clickup.List{}, err
+	}
+
+	if err := m.invalidateList(list); err != nil {
+		return clickup.List{}, err
+	}
+
+	return m.SyncList(list.Id)
+}
@@ -0,0 +1,154 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/prgrs/clickup/pkg/clickup"
+)
+
+const (
+	// MaxImageBytes limits the size of downloaded images.
+	MaxImageBytes = 20 << 20
+	// MaxImagePixels limits the size of decoded images, which take 4 bytes
+	// per pixel in memory.
+	MaxImagePixels = 40_000_000
+)
+
+var ErrImageTooLarge = errors.New("image is too large")
+
+// DownloadAttachment saves the attachment to the directory, created if it
+// does not exist, and returns the path of the file. Existing files are not
+// overwritten, a number is added to the name instead.
+func (m *Api) DownloadAttachment(a clickup.Attachment, dir string) (string, error) {
+	m.logger.Debug("Downloading an attachment", "id", a.Id, "dir", dir)
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	f, err := createUnique(dir, attachmentFilename(a))
+	if err != nil {
+		return "", err
+	}
+
+	if err := m.Clickup.DownloadAttachment(a, f); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return "", err
+	}
+
+	return f.Name(), f.Close()
+}
+
+// UploadAttachment attaches the file to the task and returns the updated
+// task.
+func (m *Api) UploadAttachment(taskId string, path string) (clickup.Task, error) {
+	m.logger.Debug("Uploading an attachment", "taskId", taskId, "path", path)
+
+	f, err := os.Open(path)
+	if err != nil {
+		return clickup.Task{}, err
+	}
+	defer f.Close()
+
+	if _, err := m.Clickup.UploadAttachment(taskId, filepath.Base(path), f); err != nil {
+		return clickup.Task{}, err
+	}
+
+	return m.syncChangedTask(taskId)
+}
+
+// GetImage downloads and decodes the image, e.g. one embedded in the
+// description of a task. PNG, JPEG and GIF images are supported. Images
+// larger than MaxImageBytes or MaxImagePixels are refused before they are
+// decoded.
+func (m *Api) GetImage(url string) (image.Image, error) {
+	m.logger.Debug("Getting an image", "url", url)
+
+	body, err := m.Clickup.OpenAttachment(clickup.Attachment{Title: path.Base(url), Url: url})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(body, MaxImageBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > MaxImageBytes {
+		return nil, fmt.Errorf("%w: more than %d bytes", ErrImageTooLarge, MaxImageBytes)
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if int64(config.Width)*int64(config.Height) > MaxImagePixels {
+		return nil, fmt.Errorf("%w: %dx%d pixels", ErrImageTooLarge, config.Width, config.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	return img, err
+}
+
+// attachmentFilename returns the title of the attachment safe to be used as
+// a file name. Directories in the title are dropped so the file cannot be
+// written outside of the download directory.
+func attachmentFilename(a clickup.Attachment) string {
+	name := baseName(a.Title)
+	if name == "" {
+		name = baseName(a.Id)
+	}
+	if name == "" {
+		name = "attachment"
+	}
+
+	if filepath.Ext(name) == "" && a.Extension != "" {
+		name += "." + a.Extension
+	}
+
+	return name
+}
+
+// baseName returns the last element of the path separated by slashes or
+// backslashes, or an empty string if it does not name a file.
+func baseName(p string) string {
+	name := path.Base(strings.ReplaceAll(strings.TrimSpace(p), "\\", "/"))
+	if name == "." || name == "/" || name == ".." {
+		return ""
+	}
+
+	return name
+}
+
+// createUnique creates the file adding " (n)" before its extension if a file
+// with the name already exists.
+func createUnique(dir string, name string) (*os.File, error) {
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+
+	for i := 0; ; i++ {
+		candidate := name
+		if i > 0 {
+			candidate = fmt.Sprintf("%s (%d)%s", base, i, ext)
+		}
+
+		f, err := os.OpenFile(filepath.Join(dir, candidate), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if errors.Is(err, os.ErrExist) {
+			continue
+		}
+
+		return f, err
+	}
+}
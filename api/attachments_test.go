@@ -0,0 +1,288 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"image"
+	"image/png"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/prgrs/clickup/pkg/clickup"
+)
+
+// upload is a file received by attachmentServer.
+type upload struct {
+	taskId   string
+	filename string
+	content  string
+}
+
+// attachmentServer receives uploaded attachments of a task and serves files.
+type attachmentServer struct {
+	mutex   sync.Mutex
+	uploads []upload
+	auth    []string
+}
+
+func (s *attachmentServer) handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /api/v2/task/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		task := testTask(r.PathValue("id"), "Task", "open", "1")
+		for _, u := range s.uploads {
+			task.Attachments = append(task.Attachments, clickup.Attachment{Title: u.filename})
+		}
+
+		_ = json.NewEncoder(w).Encode(task)
+	})
+
+	mux.HandleFunc("POST /api/v2/task/{id}/attachment", func(w http.ResponseWriter, r *http.Request) {
+		file, header, err := r.FormFile("attachment")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+
+		content, err := io.ReadAll(file)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		s.auth = append(s.auth, r.Header.Get("Authorization"))
+		s.uploads = append(s.uploads, upload{
+			taskId:   r.PathValue("id"),
+			filename: header.Filename,
+			content:  string(content),
+		})
+
+		_ = json.NewEncoder(w).Encode(clickup.Attachment{Id: "attachment", Title: header.Filename})
+	})
+
+	mux.HandleFunc("GET /files/{name}", func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		s.auth = append(s.auth, r.Header.Get("Authorization"))
+
+		if r.PathValue("name") == "missing" {
+			http.NotFound(w, r)
+			return
+		}
+
+		_, _ = w.Write([]byte("content of " + r.PathValue("name")))
+	})
+
+	return mux
+}
+
+func newAttachmentApi(t *testing.T) (*Api, *attachmentServer, string) {
+	t.Helper()
+
+	s := &attachmentServer{}
+	srv := httptest.NewServer(s.handler())
+	t.Cleanup(srv.Close)
+
+	a := newCacheApi(t)
+	a.Clickup = clickup.NewClient("token", srv.URL+"/api/v2", slog.Default())
+
+	return a, s, srv.URL
+}
+
+func TestUploadAttachment(t *testing.T) {
+	a, s, _ := newAttachmentApi(t)
+
+	path := filepath.Join(t.TempDir(), "report.txt")
+	if err := os.WriteFile(path, []byte("line 1\nline 2\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	task, err := a.UploadAttachment("1", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := upload{taskId: "1", filename: "report.txt", content: "line 1\nline 2\n"}
+	if len(s.uploads) != 1 || s.uploads[0] != want {
+		t.Errorf("uploads = %+v, want [%+v]", s.uploads, want)
+	}
+	if len(s.auth) != 1 || s.auth[0] != "token" {
+		t.Errorf("authorization = %q, want the token", s.auth)
+	}
+	if len(task.Attachments) != 1 || task.Attachments[0].Title != "report.txt" {
+		t.Errorf("attachments of the task = %+v, want the uploaded one", task.Attachments)
+	}
+}
+
+func TestUploadAttachmentMissingFile(t *testing.T) {
+	a, s, _ := newAttachmentApi(t)
+
+	if _, err := a.UploadAttachment("1", filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("UploadAttachment() of a missing file succeeded")
+	}
+	if len(s.uploads) != 0 {
+		t.Errorf("uploads = %+v, want none", s.uploads)
+	}
+}
+
+func TestDownloadAttachment(t *testing.T) {
+	a, s, url := newAttachmentApi(t)
+
+	root := t.TempDir()
+	dir := filepath.Join(root, "downloads")
+
+	attachment := clickup.Attachment{
+		Id:    "1",
+		Title: "../../report.txt",
+		Url:   url + "/files/report.txt",
+	}
+
+	for _, want := range []string{"report.txt", "report (1).txt"} {
+		path, err := a.DownloadAttachment(attachment, dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if path != filepath.Join(dir, want) {
+			t.Errorf("path = %q, want %q", path, filepath.Join(dir, want))
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(content) != "content of report.txt" {
+			t.Errorf("content = %q, want %q", content, "content of report.txt")
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(root, "report.txt")); !os.IsNotExist(err) {
+		t.Error("attachment was written outside of the download directory")
+	}
+
+	for _, auth := range s.auth {
+		if auth != "" {
+			t.Errorf("authorization = %q, want the token not sent to the signed url", auth)
+		}
+	}
+}
+
+func TestDownloadAttachmentFailed(t *testing.T) {
+	a, _, url := newAttachmentApi(t)
+
+	dir := t.TempDir()
+	attachment := clickup.Attachment{Id: "1", Title: "missing", Url: url + "/files/missing"}
+
+	if _, err := a.DownloadAttachment(attachment, dir); err == nil {
+		t.Fatal("DownloadAttachment() of a missing file succeeded")
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("files left after the failed download: %v", entries)
+	}
+}
+
+func TestAttachmentFilename(t *testing.T) {
+	tests := []struct {
+		name       string
+		attachment clickup.Attachment
+		want       string
+	}{
+		{"title", clickup.Attachment{Id: "1", Title: "report.pdf"}, "report.pdf"},
+		{"extension added", clickup.Attachment{Id: "1", Title: "report", Extension: "pdf"}, "report.pdf"},
+		{"extension kept", clickup.Attachment{Id: "1", Title: "report.txt", Extension: "pdf"}, "report.txt"},
+		{"parent directories", clickup.Attachment{Id: "1", Title: "../../etc/passwd"}, "passwd"},
+		{"absolute path", clickup.Attachment{Id: "1", Title: "/etc/passwd"}, "passwd"},
+		{"windows path", clickup.Attachment{Id: "1", Title: `..\..\Windows\win.ini`}, "win.ini"},
+		{"trailing slash", clickup.Attachment{Id: "1", Title: "dir/"}, "dir"},
+		{"parent directory only", clickup.Attachment{Id: "1", Title: ".."}, "1"},
+		{"root only", clickup.Attachment{Id: "1", Title: "/", Extension: "png"}, "1.png"},
+		{"empty title", clickup.Attachment{Id: "1", Title: ""}, "1"},
+		{"traversal in id", clickup.Attachment{Id: "../1", Title: ".."}, "1"},
+		{"no title nor id", clickup.Attachment{Title: "..", Id: ".."}, "attachment"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := attachmentFilename(tt.attachment)
+			if got != tt.want {
+				t.Errorf("attachmentFilename() = %q, want %q", got, tt.want)
+			}
+
+			if strings.ContainsAny(got, `/\`) {
+				t.Errorf("attachmentFilename() = %q contains a path separator", got)
+			}
+		})
+	}
+}
+
+func TestGetImage(t *testing.T) {
+	pixels := bytes.Buffer{}
+	if err := png.Encode(&pixels, image.NewGray(image.Rect(0, 0, 3, 2))); err != nil {
+		t.Fatal(err)
+	}
+
+	// The header of a GIF claiming 65535x65535 pixels without the pixels.
+	huge := []byte("GIF89a\xff\xff\xff\xff\x00\x00\x00;")
+
+	files := map[string][]byte{
+		"/pixels.png": pixels.Bytes(),
+		"/huge.gif":   huge,
+		"/large.png":  append(pixels.Bytes(), make([]byte, MaxImageBytes)...),
+		"/text.txt":   []byte("not an image"),
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		_, _ = w.Write(content)
+	}))
+	defer srv.Close()
+
+	a := newCacheApi(t)
+	a.Clickup = clickup.NewClient("token", srv.URL, slog.Default())
+
+	img, err := a.GetImage(srv.URL + "/pixels.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := img.Bounds(); b.Dx() != 3 || b.Dy() != 2 {
+		t.Errorf("image size = %dx%d, want 3x2", b.Dx(), b.Dy())
+	}
+
+	for _, name := range []string{"/huge.gif", "/large.png"} {
+		if _, err := a.GetImage(srv.URL + name); !errors.Is(err, ErrImageTooLarge) {
+			t.Errorf("GetImage(%s) error = %v, want %v", name, err, ErrImageTooLarge)
+		}
+	}
+
+	for _, name := range []string{"/text.txt", "/missing.png"} {
+		if _, err := a.GetImage(srv.URL + name); err == nil {
+			t.Errorf("GetImage(%s) succeeded", name)
+		}
+	}
+}
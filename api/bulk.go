@@ -0,0 +1,76 @@
+package api
+
+import (
+	"errors"
+
+	"github.com/prgrs/clickup/pkg/clickup"
+	"golang.org/x/sync/errgroup"
+)
+
+// UpdateConcurrency limits the number of tasks updated at once in bulk.
+const UpdateConcurrency = 4
+
+var ErrUnknownWorkspace = errors.New("workspace not found")
+
+// TaskUpdateResult is the outcome of updating a single task in bulk. Task is
+// the updated task or the requested one if the update failed.
+type TaskUpdateResult struct {
+	Task clickup.Task
+	Err  error
+}
+
+// UpdateTasks updates the fields of the tasks through UpdateTask with at most
+// UpdateConcurrency updates at once. Results are sent in the order the updates
+// finish and the channel is closed after the last one.
+func (m *Api) UpdateTasks(tasks []clickup.Task, fields ...clickup.TaskField) <-chan TaskUpdateResult {
+	m.logger.Debug("Updating tasks", "count", len(tasks))
+
+	results := make(chan TaskUpdateResult, len(tasks))
+
+	go func() {
+		defer close(results)
+
+		g := new(errgroup.Group)
+		g.SetLimit(UpdateConcurrency)
+
+		for _, task := range tasks {
+			g.Go(func() error {
+				t, err := m.UpdateTask(task, fields...)
+				if err != nil {
+					m.logger.Error("Failed to update task", "taskId", task.Id, "error", err)
+					t = task
+				}
+
+				results <- TaskUpdateResult{Task: t, Err: err}
+				return nil
+			})
+		}
+
+		_ = g.Wait()
+	}()
+
+	return results
+}
+
+// GetMembers returns users of the workspace.
+func (m *Api) GetMembers(teamId string) ([]clickup.User, error) {
+	teams, err := m.GetTeams()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, team := range teams {
+		if team.Id != teamId {
+			continue
+		}
+
+		users := make([]clickup.User, len(team.Members))
+		for i, member := range team.Members {
+			users[i] = member.User
+		}
+
+		return users, nil
+	}
+
+	return nil, ErrUnknownWorkspace
+}
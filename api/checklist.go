@@ -0,0 +1,38 @@
+package api
+
+import "github.com/prgrs/clickup/pkg/clickup"
+
+// CreateChecklist adds a checklist to the task and returns the updated task.
+func (m *Api) CreateChecklist(taskId string, name string) (clickup.Task, error) {
+	m.logger.Debug("Creating a checklist", "taskId", taskId, "name", name)
+
+	if _, err := m.Clickup.CreateChecklist(taskId, name); err != nil {
+		return clickup.Task{}, err
+	}
+
+	return m.syncChangedTask(taskId)
+}
+
+// AddChecklistItem adds an item to the checklist of the task and returns the
+// updated task.
+func (m *Api) AddChecklistItem(taskId string, checklistId string, name string) (clickup.Task, error) {
+	m.logger.Debug("Adding a checklist item", "taskId", taskId, "checklistId", checklistId, "name", name)
+
+	if _, err := m.Clickup.CreateChecklistItem(checklistId, name); err != nil {
+		return clickup.Task{}, err
+	}
+
+	return m.syncChangedTask(taskId)
+}
+
+// SetChecklistItemResolved ticks or unticks the item of the checklist of the
+// task and returns the updated task.
+func (m *Api) SetChecklistItemResolved(taskId string, checklistId string, itemId string, resolved bool) (clickup.Task, error) {
+	m.logger.Debug("Setting checklist item resolved", "taskId", taskId, "itemId", itemId, "resolved", resolved)
+
+	if _, err := m.Clickup.SetChecklistItemResolved(checklistId, itemId, resolved); err != nil {
+		return clickup.Task{}, err
+	}
+
+	return m.syncChangedTask(taskId)
+}
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/prgrs/clickup/pkg/clickup"
+	"github.com/prgrs/clickup/pkg/merge"
+)
+
+// textFields are merged line by line when the task was edited concurrently.
+var textFields = []struct {
+	field clickup.TaskField
+	label string
+	value func(t *clickup.Task) *string
+}{
+	{clickup.TaskFieldName, "name", func(t *clickup.Task) *string { return &t.Name }},
+	{clickup.TaskFieldMarkdownDescription, "description", func(t *clickup.Task) *string { return &t.MarkdownDescription }},
+}
+
+// MergeConflictError is returned by UpdateTaskFrom when a text field was
+// changed differently by the edit and remotely. Latest is the task as it is
+// now and Merged is the edit applied on top of it with the conflicting field
+// holding conflict markers to be resolved.
+type MergeConflictError struct {
+	Field  clickup.TaskField
+	Latest clickup.Task
+	Merged clickup.Task
+}
+
+func (e MergeConflictError) Error() string {
+	label := string(e.Field)
+	for _, f := range textFields {
+		if f.field == e.Field {
+			label = f.label
+		}
+	}
+
+	return fmt.Sprintf("%s of task %q was changed since the edit started", label, e.Latest.Name)
+}
+
+// UpdateTaskFrom updates the fields of the task edited from the base version.
+// Without fields the ones differing from base are updated. If the task was
+// updated since, the edit is merged with the latest version first and
+// MergeConflictError is returned if it cannot be merged automatically.
+func (m *Api) UpdateTaskFrom(base clickup.Task, task clickup.Task, fields ...clickup.TaskField) (clickup.Task, error) {
+	if len(fields) == 0 {
+		fields = clickup.ChangedFields(base, task)
+	}
+
+	if len(fields) == 0 {
+		return m.GetTask(task.Id)
+	}
+
+	latest, err := m.SyncTask(task.Id)
+	if err != nil {
+		return clickup.Task{}, err
+	}
+
+	if latest.DateUpdated == base.DateUpdated {
+		return m.UpdateTask(task, fields...)
+	}
+
+	m.logger.Info("Task was changed since the edit started",
+		"taskId", task.Id, "base", base.DateUpdated, "latest", latest.DateUpdated)
+
+	merged, conflict := mergeTask(base, task, latest, fields)
+	if conflict != "" {
+		return clickup.Task{}, MergeConflictError{
+			Field:  conflict,
+			Latest: latest,
+			Merged: merged,
+		}
+	}
+
+	return m.UpdateTask(merged, fields...)
+}
+
+// mergeTask applies the fields changed by the edit made to base on top of the
+// latest version. It returns the first text field that could not be merged.
+func mergeTask(base clickup.Task, edit clickup.Task, latest clickup.Task, fields []clickup.TaskField) (clickup.Task, clickup.TaskField) {
+	merged := clickup.CopyFields(latest, edit, fields...)
+
+	var conflict clickup.TaskField
+	for _, f := range textFields {
+		if !slices.Contains(fields, f.field) {
+			continue
+		}
+
+		value, clean := merge.Merge(*f.value(&base), *f.value(&edit), *f.value(&latest))
+		*f.value(&merged) = value
+
+		if !clean && conflict == "" {
+			conflict = f.field
+		}
+	}
+
+	return merged, conflict
+}
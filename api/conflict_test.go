@@ -0,0 +1,214 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/prgrs/clickup/pkg/clickup"
+	"github.com/prgrs/clickup/pkg/merge"
+)
+
+// editServer keeps a single task and records bodies of its updates.
+type editServer struct {
+	mutex   sync.Mutex
+	task    clickup.Task
+	updates []map[string]interface{}
+}
+
+func (s *editServer) handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /api/v2/task/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		_ = json.NewEncoder(w).Encode(s.task)
+	})
+
+	mux.HandleFunc("PUT /api/v2/task/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		body := map[string]interface{}{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.updates = append(s.updates, body)
+
+		if name, ok := body["name"].(string); ok {
+			s.task.Name = name
+		}
+		if description, ok := body["markdown_description"].(string); ok {
+			s.task.MarkdownDescription = description
+		}
+		s.change()
+
+		_ = json.NewEncoder(w).Encode(s.task)
+	})
+
+	return mux
+}
+
+// change marks the task as updated.
+func (s *editServer) change() {
+	updated, _ := strconv.Atoi(s.task.DateUpdated)
+	s.task.DateUpdated = strconv.Itoa(updated + 1)
+}
+
+// edit changes the description of the task remotely.
+func (s *editServer) edit(description string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.task.MarkdownDescription = description
+	s.change()
+}
+
+func (s *editServer) sentDescriptions() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var descriptions []string
+	for _, body := range s.updates {
+		descriptions = append(descriptions, body["markdown_description"].(string))
+	}
+
+	return descriptions
+}
+
+func newEditApi(t *testing.T, description string) (*Api, *editServer) {
+	t.Helper()
+
+	task := testTask("1", "Task", "open", "1")
+	task.MarkdownDescription = description
+
+	s := &editServer{task: task}
+	srv := httptest.NewServer(s.handler())
+	t.Cleanup(srv.Close)
+
+	a := newCacheApi(t)
+	a.Clickup = clickup.NewClient("token", srv.URL+"/api/v2", slog.Default())
+
+	return a, s
+}
+
+func TestUpdateTaskFrom(t *testing.T) {
+	base := lines("one", "two", "three")
+
+	tests := []struct {
+		name   string
+		remote string
+		local  string
+		want   string
+	}{
+		{
+			name:   "not changed remotely",
+			remote: "",
+			local:  lines("one", "TWO", "three"),
+			want:   lines("one", "TWO", "three"),
+		},
+		{
+			name:   "other lines changed remotely",
+			remote: lines("ONE", "two", "three"),
+			local:  lines("one", "two", "THREE"),
+			want:   lines("ONE", "two", "THREE"),
+		},
+		{
+			name:   "same change made remotely",
+			remote: lines("one", "TWO", "three"),
+			local:  lines("one", "TWO", "three"),
+			want:   lines("one", "TWO", "three"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, s := newEditApi(t, base)
+
+			started, err := a.GetTask("1")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.remote != "" {
+				s.edit(tt.remote)
+			}
+
+			edit := started
+			edit.MarkdownDescription = tt.local
+
+			got, err := a.UpdateTaskFrom(started, edit, clickup.TaskFieldMarkdownDescription)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got.MarkdownDescription != tt.want {
+				t.Errorf("description = %q, want %q", got.MarkdownDescription, tt.want)
+			}
+			if sent := s.sentDescriptions(); len(sent) != 1 || sent[0] != tt.want {
+				t.Errorf("sent descriptions = %q, want [%q]", sent, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateTaskFromConflict(t *testing.T) {
+	a, s := newEditApi(t, lines("one", "two", "three"))
+
+	started, err := a.GetTask("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.edit(lines("one", "remote", "three"))
+
+	edit := started
+	edit.MarkdownDescription = lines("one", "local", "three")
+
+	_, err = a.UpdateTaskFrom(started, edit, clickup.TaskFieldMarkdownDescription)
+
+	var conflict MergeConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("UpdateTaskFrom() error = %v, want MergeConflictError", err)
+	}
+	if conflict.Field != clickup.TaskFieldMarkdownDescription {
+		t.Errorf("conflicting field = %q, want %q", conflict.Field, clickup.TaskFieldMarkdownDescription)
+	}
+	if conflict.Latest.MarkdownDescription != lines("one", "remote", "three") {
+		t.Errorf("latest description = %q, want the remote change", conflict.Latest.MarkdownDescription)
+	}
+	if !merge.HasConflicts(conflict.Merged.MarkdownDescription) {
+		t.Errorf("merged description = %q, want conflict markers", conflict.Merged.MarkdownDescription)
+	}
+	if sent := s.sentDescriptions(); len(sent) != 0 {
+		t.Fatalf("sent descriptions = %q, want none before the conflict is resolved", sent)
+	}
+
+	// Resolved in the editor and saved again from the latest version.
+	resolved := conflict.Merged
+	resolved.MarkdownDescription = lines("one", "local", "remote", "three")
+
+	got, err := a.UpdateTaskFrom(conflict.Latest, resolved, clickup.TaskFieldMarkdownDescription)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.MarkdownDescription != resolved.MarkdownDescription {
+		t.Errorf("description = %q, want %q", got.MarkdownDescription, resolved.MarkdownDescription)
+	}
+	if !a.History.CanUndo() {
+		t.Error("resolved edit was not recorded to be undone")
+	}
+}
+
+func lines(s ...string) string {
+	return strings.Join(s, "\n")
+}
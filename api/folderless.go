@@ -0,0 +1,32 @@
+package api
+
+import (
+	"strings"
+
+	"github.com/prgrs/clickup/pkg/clickup"
+)
+
+// Lists which are not in any folder are shown in the navigator under a pseudo
+// folder of their space. Its id is the space id with a prefix which can not
+// collide with ids of real folders.
+const folderlessFolderIdPrefix = "folderless:"
+
+// FolderlessFolderName is the name of the pseudo folder holding folderless
+// lists.
+const FolderlessFolderName = "Folderless lists"
+
+// FolderlessFolder returns the pseudo folder holding folderless lists of the
+// space.
+func FolderlessFolder(spaceId string) clickup.Folder {
+	return clickup.Folder{
+		Id:    folderlessFolderIdPrefix + spaceId,
+		Name:  FolderlessFolderName,
+		Space: clickup.Space{Id: spaceId},
+	}
+}
+
+// ParseFolderlessFolderId returns the space id of a pseudo folder made by
+// FolderlessFolder and false if the id is of a real folder.
+func ParseFolderlessFolderId(id string) (string, bool) {
+	return strings.CutPrefix(id, folderlessFolderIdPrefix)
+}
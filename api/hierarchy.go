@@ -0,0 +1,147 @@
+package api
+
+import (
+	"errors"
+
+	"github.com/prgrs/clickup/pkg/cache"
+	"github.com/prgrs/clickup/pkg/clickup"
+)
+
+// Changes of spaces, folders and lists drop the cached children of their
+// parent, so the navigator fetches them again on the next access.
+
+func (m *Api) CreateSpace(teamId string, name string) (clickup.Space, error) {
+	m.logger.Debug("Creating a space", "teamId", teamId, "name", name)
+
+	space, err := m.Clickup.CreateSpace(teamId, clickup.RequestCreateSpace{
+		Name:              name,
+		MultipleAssignees: true,
+	})
+	if err != nil {
+		return clickup.Space{}, err
+	}
+
+	return space, m.invalidateKey(CacheNamespaceSpaces, cache.Key(teamId))
+}
+
+func (m *Api) CreateFolder(spaceId string, name string) (clickup.Folder, error) {
+	m.logger.Debug("Creating a folder", "spaceId", spaceId, "name", name)
+
+	folder, err := m.Clickup.CreateFolder(spaceId, clickup.RequestFolder{Name: name})
+	if err != nil {
+		return clickup.Folder{}, err
+	}
+
+	return folder, m.invalidateKey(CacheNamespaceFolders, cache.Key(spaceId))
+}
+
+func (m *Api) RenameFolder(folder clickup.Folder, name string) (clickup.Folder, error) {
+	m.logger.Debug("Renaming a folder", "folderId", folder.Id, "name", name)
+
+	f, err := m.Clickup.UpdateFolder(folder.Id, clickup.RequestFolder{Name: name})
+	if err != nil {
+		return clickup.Folder{}, err
+	}
+
+	return f, m.invalidateFolder(folder)
+}
+
+func (m *Api) DeleteFolder(folder clickup.Folder) error {
+	m.logger.Debug("Deleting a folder", "folderId", folder.Id)
+
+	if err := m.Clickup.DeleteFolder(folder.Id); err != nil {
+		return err
+	}
+
+	folderId := cache.Key(folder.Id)
+
+	return errors.Join(
+		m.invalidateFolder(folder),
+		m.invalidateKey(CacheNamespaceListsFolder, folderId),
+		m.invalidateKey(CacheNamespaceListsFolderArchived, folderId),
+		m.invalidateKey(CacheNamespaceViewsFolder, folderId),
+	)
+}
+
+// CreateList creates a list in the folder or, for the id of a pseudo folder
+// made by FolderlessFolder, a folderless list in its space.
+func (m *Api) CreateList(folderId string, name string) (clickup.List, error) {
+	m.logger.Debug("Creating a list", "folderId", folderId, "name", name)
+
+	r := clickup.RequestList{Name: name}
+
+	if spaceId, ok := ParseFolderlessFolderId(folderId); ok {
+		list, err := m.Clickup.CreateFolderlessList(spaceId, r)
+		if err != nil {
+			return clickup.List{}, err
+		}
+
+		return list, errors.Join(
+			m.invalidateKey(CacheNamespaceListsSpace, cache.Key(spaceId)),
+			// the pseudo folder is listed only if the space has folderless lists
+			m.invalidateKey(CacheNamespaceFolders, cache.Key(spaceId)),
+		)
+	}
+
+	list, err := m.Clickup.CreateList(folderId, r)
+	if err != nil {
+		return clickup.List{}, err
+	}
+
+	return list, m.invalidateKey(CacheNamespaceListsFolder, cache.Key(folderId))
+}
+
+func (m *Api) RenameList(list clickup.List, name string) (clickup.List, error) {
+	m.logger.Debug("Renaming a list", "listId", list.Id, "name", name)
+
+	l, err := m.Clickup.UpdateList(list.Id, clickup.RequestList{Name: name})
+	if err != nil {
+		return clickup.List{}, err
+	}
+
+	return l, m.invalidateList(list)
+}
+
+func (m *Api) DeleteList(list clickup.List) error {
+	m.logger.Debug("Deleting a list", "listId", list.Id)
+
+	if err := m.Clickup.DeleteList(list.Id); err != nil {
+		return err
+	}
+
+	listId := cache.Key(list.Id)
+
+	return errors.Join(
+		m.invalidateList(list),
+		m.invalidateKey(CacheNamespaceTasksList, listId),
+		m.invalidateKey(CacheNamespaceTasksListArchived, listId),
+		m.invalidateKey(CacheNamespaceViewsList, listId),
+	)
+}
+
+// invalidateFolder drops cached folders of the space the folder belongs to.
+func (m *Api) invalidateFolder(folder clickup.Folder) error {
+	spaceId := cache.Key(folder.Space.Id)
+
+	return errors.Join(
+		m.invalidateKey(CacheNamespaceFolders, spaceId),
+		m.invalidateKey(CacheNamespaceFoldersArchived, spaceId),
+	)
+}
+
+// invalidateList drops the cached list and cached lists of its folder and
+// space.
+func (m *Api) invalidateList(list clickup.List) error {
+	var (
+		folderId = cache.Key(list.Folder.ID)
+		spaceId  = cache.Key(list.Space.ID)
+	)
+
+	return errors.Join(
+		m.invalidateKey(CacheNamespaceLists, cache.Key(list.Id)),
+		m.invalidateKey(CacheNamespaceListsFolder, folderId),
+		m.invalidateKey(CacheNamespaceListsFolderArchived, folderId),
+		m.invalidateKey(CacheNamespaceListsSpace, spaceId),
+		m.invalidateKey(CacheNamespaceListsSpaceArchived, spaceId),
+	)
+}
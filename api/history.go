@@ -0,0 +1,138 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/prgrs/clickup/pkg/clickup"
+)
+
+// HistoryLimit is the number of task edits that can be undone.
+const HistoryLimit = 100
+
+var (
+	ErrNothingToUndo = errors.New("nothing to undo")
+	ErrNothingToRedo = errors.New("nothing to redo")
+)
+
+// ConflictError is returned when the task was changed by someone else since
+// the edit that is undone or redone.
+type ConflictError struct {
+	Task clickup.Task
+}
+
+func (e ConflictError) Error() string {
+	return fmt.Sprintf("task %q was changed since the edit", e.Task.Name)
+}
+
+// revision is a task edit that can be reverted. Applied is the task as it
+// was left by the edit and Revert is the snapshot to restore Fields from.
+type revision struct {
+	Applied clickup.Task
+	Revert  clickup.Task
+	Fields  []clickup.TaskField
+}
+
+// History keeps task edits made through UpdateTask to undo and redo them.
+type History struct {
+	mutex sync.Mutex
+	undo  []revision
+	redo  []revision
+}
+
+func NewHistory() *History {
+	return &History{}
+}
+
+// record adds the edit and drops edits that were undone.
+func (h *History) record(r revision) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.undo = push(h.undo, r)
+	h.redo = nil
+}
+
+func (h *History) CanUndo() bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	return len(h.undo) > 0
+}
+
+func (h *History) CanRedo() bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	return len(h.redo) > 0
+}
+
+func push(revisions []revision, r revision) []revision {
+	revisions = append(revisions, r)
+	if len(revisions) > HistoryLimit {
+		revisions = revisions[len(revisions)-HistoryLimit:]
+	}
+
+	return revisions
+}
+
+// Undo restores the task changed by the last edit. Unless force is set it
+// fails with ConflictError if the task was updated since the edit and the
+// edit is kept to be undone later.
+func (m *Api) Undo(force bool) (clickup.Task, error) {
+	return m.revert(&m.History.undo, &m.History.redo, force, ErrNothingToUndo)
+}
+
+// Redo applies the last undone edit again. Conflicts are detected the same
+// way as by Undo.
+func (m *Api) Redo(force bool) (clickup.Task, error) {
+	return m.revert(&m.History.redo, &m.History.undo, force, ErrNothingToRedo)
+}
+
+// revert applies the last revision from one stack and adds its reverse to
+// the other one.
+func (m *Api) revert(from *[]revision, to *[]revision, force bool, errEmpty error) (clickup.Task, error) {
+	h := m.History
+
+	h.mutex.Lock()
+	if len(*from) == 0 {
+		h.mutex.Unlock()
+		return clickup.Task{}, errEmpty
+	}
+	r := (*from)[len(*from)-1]
+	*from = (*from)[:len(*from)-1]
+	h.mutex.Unlock()
+
+	// keep the revision if it is not applied
+	keep := func() {
+		h.mutex.Lock()
+		*from = push(*from, r)
+		h.mutex.Unlock()
+	}
+
+	m.logger.Debug("Reverting a task edit", "taskId", r.Revert.Id)
+
+	current, err := m.SyncTask(r.Applied.Id)
+	if err != nil {
+		keep()
+		return clickup.Task{}, err
+	}
+
+	if !force && current.DateUpdated != r.Applied.DateUpdated {
+		keep()
+		return clickup.Task{}, ConflictError{Task: current}
+	}
+
+	t, err := m.updateTask(current, r.Revert, r.Fields)
+	if err != nil {
+		keep()
+		return clickup.Task{}, err
+	}
+
+	h.mutex.Lock()
+	*to = push(*to, revision{Applied: t, Revert: current, Fields: r.Fields})
+	h.mutex.Unlock()
+
+	return t, nil
+}
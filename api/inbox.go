@@ -0,0 +1,182 @@
+package api
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prgrs/clickup/pkg/clickup"
+)
+
+// Change is a field of a task that changed between syncs.
+type Change struct {
+	Field string
+	Old   string
+	New   string
+}
+
+func (c Change) String() string {
+	if c.Old == "" && c.New == "" {
+		return c.Field + " edited"
+	}
+
+	return fmt.Sprintf("%s: %s → %s", c.Field, valueOrNone(c.Old), valueOrNone(c.New))
+}
+
+func valueOrNone(v string) string {
+	if v == "" {
+		return "none"
+	}
+
+	return v
+}
+
+// Notification groups changes of a task detected in a single sync.
+type Notification struct {
+	Id       string
+	TaskId   string
+	TaskName string
+	Url      string
+	Changes  []Change
+	Ts       time.Time
+	Read     bool
+}
+
+func (n Notification) Body() string {
+	s := make([]string, len(n.Changes))
+	for i, c := range n.Changes {
+		s[i] = c.String()
+	}
+
+	return strings.Join(s, "\n")
+}
+
+// MaxInboxItems limits the notifications kept in the inbox, the oldest ones
+// are dropped first.
+const MaxInboxItems = 500
+
+// Inbox keeps notifications about changed tasks.
+type Inbox struct {
+	mutex sync.RWMutex
+	items []Notification
+}
+
+func NewInbox() *Inbox {
+	return &Inbox{
+		items: []Notification{},
+	}
+}
+
+// Add records a notification unless one with the same id is already present
+// which happens when a task is cached in more than one namespace.
+func (i *Inbox) Add(n Notification) bool {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	for _, item := range i.items {
+		if item.Id == n.Id {
+			return false
+		}
+	}
+
+	i.items = append(i.items, n)
+	if len(i.items) > MaxInboxItems {
+		i.items = slices.Delete(i.items, 0, len(i.items)-MaxInboxItems)
+	}
+
+	return true
+}
+
+// Items returns notifications starting from the newest.
+func (i *Inbox) Items() []Notification {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	items := make([]Notification, len(i.items))
+	for j, item := range i.items {
+		items[len(i.items)-1-j] = item
+	}
+
+	return items
+}
+
+func (i *Inbox) Unread() int {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	unread := 0
+	for _, item := range i.items {
+		if !item.Read {
+			unread++
+		}
+	}
+
+	return unread
+}
+
+func (i *Inbox) MarkRead(id string) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	for j := range i.items {
+		if i.items[j].Id == id {
+			i.items[j].Read = true
+		}
+	}
+}
+
+func (i *Inbox) MarkAllRead() {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	for j := range i.items {
+		i.items[j].Read = true
+	}
+}
+
+// diffTasks returns changes of the fields users care about.
+func diffTasks(old clickup.Task, new clickup.Task) []Change {
+	changes := []Change{}
+
+	if old.Name != new.Name {
+		changes = append(changes, Change{Field: "name", Old: old.Name, New: new.Name})
+	}
+
+	if old.Status.Status != new.Status.Status {
+		changes = append(changes, Change{Field: "status", Old: old.Status.Status, New: new.Status.Status})
+	}
+
+	if o, n := assigneesString(old), assigneesString(new); o != n {
+		changes = append(changes, Change{Field: "assignees", Old: o, New: n})
+	}
+
+	if o, n := dueDateString(old), dueDateString(new); o != n {
+		changes = append(changes, Change{Field: "due date", Old: o, New: n})
+	}
+
+	if old.Description != new.Description {
+		changes = append(changes, Change{Field: "description"})
+	}
+
+	return changes
+}
+
+func assigneesString(t clickup.Task) string {
+	s := make([]string, len(t.Assignees))
+	for i, a := range t.Assignees {
+		s[i] = a.Username
+	}
+
+	return strings.Join(s, ", ")
+}
+
+func dueDateString(t clickup.Task) string {
+	due, ok := t.GetDueDate()
+	if !ok {
+		return ""
+	}
+
+	return due.Format("2006-01-02 15:04")
+}
@@ -0,0 +1,255 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"testing"
+
+	"github.com/charmbracelet/log"
+	"github.com/prgrs/clickup/pkg/clickup"
+)
+
+type notification struct {
+	title string
+	body  string
+}
+
+// fakeNotifier records notifications instead of sending them.
+type fakeNotifier struct {
+	sent []notification
+	err  error
+}
+
+func (n *fakeNotifier) Notify(title string, body string) error {
+	n.sent = append(n.sent, notification{title: title, body: body})
+	return n.err
+}
+
+func newInboxApi(n *fakeNotifier) *Api {
+	return &Api{
+		Inbox:    NewInbox(),
+		notifier: n,
+		logger:   log.New(io.Discard),
+	}
+}
+
+func testTask(id string, name string, status string, updated string) clickup.Task {
+	return clickup.Task{
+		Id:          id,
+		Name:        name,
+		Status:      clickup.Status{Status: status},
+		DateUpdated: updated,
+	}
+}
+
+func TestRecordChanges(t *testing.T) {
+	tests := []struct {
+		name    string
+		old     []clickup.Task
+		new     []clickup.Task
+		want    []notification
+		wantIds []string
+	}{
+		{
+			name: "changed status",
+			old:  []clickup.Task{testTask("1", "Task", "open", "1")},
+			new:  []clickup.Task{testTask("1", "Task", "done", "2")},
+			want: []notification{
+				{title: "Task", body: "status: open → done"},
+			},
+			wantIds: []string{"1@2"},
+		},
+		{
+			name: "several fields",
+			old:  []clickup.Task{testTask("1", "Old", "open", "1")},
+			new: []clickup.Task{
+				func() clickup.Task {
+					t := testTask("1", "New", "open", "2")
+					t.Description = "changed"
+					t.Assignees = []clickup.Assignee{{Username: "john"}}
+					return t
+				}(),
+			},
+			want: []notification{
+				{title: "New", body: "name: Old → New\nassignees: none → john\ndescription edited"},
+			},
+			wantIds: []string{"1@2"},
+		},
+		{
+			name:    "unchanged",
+			old:     []clickup.Task{testTask("1", "Task", "open", "1")},
+			new:     []clickup.Task{testTask("1", "Task", "open", "2")},
+			want:    nil,
+			wantIds: []string{},
+		},
+		{
+			name:    "new task",
+			old:     []clickup.Task{},
+			new:     []clickup.Task{testTask("1", "Task", "open", "1")},
+			want:    nil,
+			wantIds: []string{},
+		},
+		{
+			name:    "deleted task",
+			old:     []clickup.Task{testTask("1", "Task", "open", "1")},
+			new:     []clickup.Task{},
+			want:    nil,
+			wantIds: []string{},
+		},
+		{
+			name: "only changed tasks",
+			old: []clickup.Task{
+				testTask("1", "First", "open", "1"),
+				testTask("2", "Second", "open", "1"),
+			},
+			new: []clickup.Task{
+				testTask("1", "First", "open", "1"),
+				testTask("2", "Second", "closed", "2"),
+			},
+			want: []notification{
+				{title: "Second", body: "status: open → closed"},
+			},
+			wantIds: []string{"2@2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := &fakeNotifier{}
+			a := newInboxApi(n)
+
+			a.recordChanges(tt.old, tt.new)
+
+			if len(n.sent) != len(tt.want) {
+				t.Fatalf("sent %d notifications, want %d: %v", len(n.sent), len(tt.want), n.sent)
+			}
+			for i := range tt.want {
+				if n.sent[i] != tt.want[i] {
+					t.Errorf("notification %d = %+v, want %+v", i, n.sent[i], tt.want[i])
+				}
+			}
+
+			items := a.Inbox.Items()
+			if len(items) != len(tt.wantIds) {
+				t.Fatalf("inbox has %d items, want %d", len(items), len(tt.wantIds))
+			}
+			for i, id := range tt.wantIds {
+				if items[i].Id != id {
+					t.Errorf("item %d id = %q, want %q", i, items[i].Id, id)
+				}
+				if items[i].Read {
+					t.Errorf("item %d is read", i)
+				}
+			}
+		})
+	}
+}
+
+func TestRecordChangesOnce(t *testing.T) {
+	n := &fakeNotifier{}
+	a := newInboxApi(n)
+
+	old := []clickup.Task{testTask("1", "Task", "open", "1")}
+	new := []clickup.Task{testTask("1", "Task", "done", "2")}
+
+	// The same task cached in more than one namespace is reported once.
+	a.recordChanges(old, new)
+	a.recordChanges(old, new)
+
+	if len(n.sent) != 1 {
+		t.Errorf("sent %d notifications, want 1", len(n.sent))
+	}
+	if got := a.Inbox.Unread(); got != 1 {
+		t.Errorf("Unread() = %d, want 1", got)
+	}
+}
+
+func TestRecordChangesNotifierError(t *testing.T) {
+	n := &fakeNotifier{err: errors.New("no notification daemon")}
+	a := newInboxApi(n)
+
+	a.recordChanges(
+		[]clickup.Task{testTask("1", "Task", "open", "1")},
+		[]clickup.Task{testTask("1", "Task", "done", "2")},
+	)
+
+	if len(n.sent) != 1 {
+		t.Errorf("sent %d notifications, want 1", len(n.sent))
+	}
+	if got := a.Inbox.Unread(); got != 1 {
+		t.Errorf("Unread() = %d, want 1", got)
+	}
+}
+
+func TestInbox(t *testing.T) {
+	i := NewInbox()
+
+	for _, id := range []string{"a", "b", "c"} {
+		if !i.Add(Notification{Id: id}) {
+			t.Fatalf("Add(%q) = false, want true", id)
+		}
+	}
+	if i.Add(Notification{Id: "b"}) {
+		t.Error("Add of a duplicate = true, want false")
+	}
+
+	items := i.Items()
+	if len(items) != 3 || items[0].Id != "c" || items[2].Id != "a" {
+		t.Fatalf("Items() = %+v, want newest first", items)
+	}
+
+	if got := i.Unread(); got != 3 {
+		t.Errorf("Unread() = %d, want 3", got)
+	}
+
+	i.MarkRead("b")
+	if got := i.Unread(); got != 2 {
+		t.Errorf("Unread() after MarkRead = %d, want 2", got)
+	}
+	if !i.Items()[1].Read {
+		t.Error("item b is not read")
+	}
+
+	i.MarkAllRead()
+	if got := i.Unread(); got != 0 {
+		t.Errorf("Unread() after MarkAllRead = %d, want 0", got)
+	}
+}
+
+func TestInboxDropsOldest(t *testing.T) {
+	i := NewInbox()
+
+	for n := 0; n < MaxInboxItems+10; n++ {
+		i.Add(Notification{Id: strconv.Itoa(n)})
+	}
+
+	items := i.Items()
+	if len(items) != MaxInboxItems {
+		t.Fatalf("got %d items, want %d", len(items), MaxInboxItems)
+	}
+	if first, last := items[len(items)-1].Id, items[0].Id; first != "10" || last != strconv.Itoa(MaxInboxItems+9) {
+		t.Errorf("items from %s to %s, want from 10 to %d", first, last, MaxInboxItems+9)
+	}
+	if got := i.Unread(); got != MaxInboxItems {
+		t.Errorf("Unread() = %d, want %d", got, MaxInboxItems)
+	}
+}
+
+func TestChangeString(t *testing.T) {
+	tests := []struct {
+		change Change
+		want   string
+	}{
+		{Change{Field: "status", Old: "open", New: "done"}, "status: open → done"},
+		{Change{Field: "due date", Old: "", New: "2024-01-02 10:00"}, "due date: none → 2024-01-02 10:00"},
+		{Change{Field: "assignees", Old: "john", New: ""}, "assignees: john → none"},
+		{Change{Field: "description"}, "description edited"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.change.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/prgrs/clickup/pkg/cache"
+	"github.com/prgrs/clickup/pkg/clickup"
+)
+
+var ErrUnknownTaskWorkspace = errors.New("workspace of the task is unknown")
+
+// MoveTask moves the task to another list of its workspace and updates cached
+// tasks of both lists. The move is recorded to be undone like other edits.
+func (m *Api) MoveTask(task clickup.Task, listId string) (clickup.Task, error) {
+	task.List = clickup.TaskList{Id: listId}
+
+	return m.UpdateTask(task, clickup.TaskFieldList)
+}
+
+func (m *Api) moveTask(task clickup.Task, listId string) error {
+	m.logger.Debug("Moving a task", "taskId", task.Id, "from", task.List.Id, "to", listId)
+
+	if task.TeamId == "" {
+		return ErrUnknownTaskWorkspace
+	}
+
+	return m.Clickup.MoveTask(task.TeamId, task.Id, listId)
+}
+
+// moveCachedTask removes the task from cached tasks of the list it was moved
+// from and adds it to cached tasks of its current list. Views that included
+// the task are dropped from the cache to be fetched again.
+func (m *Api) moveCachedTask(task clickup.Task, fromListId string) {
+	m.tasksLock.Lock()
+	defer m.tasksLock.Unlock()
+
+	c := cache.NewTyped[[]clickup.Task](m.Cache, CacheNamespaceTasksList)
+	isTask := func(t clickup.Task) bool { return t.Id == task.Id }
+
+	if tasks, err := c.Peek(cache.Key(fromListId)); err == nil {
+		c.Set(cache.Key(fromListId), slices.DeleteFunc(slices.Clone(tasks), isTask))
+	}
+
+	if tasks, err := c.Peek(cache.Key(task.List.Id)); err == nil && !slices.ContainsFunc(tasks, isTask) {
+		c.Set(cache.Key(task.List.Id), append(slices.Clip(tasks), task))
+	}
+
+	for _, entry := range m.Cache.GetEntries() {
+		if entry.Namespace != CacheNamespaceTasksView {
+			continue
+		}
+
+		if slices.ContainsFunc(m.cachedTasks(entry), isTask) {
+			m.Cache.Delete(entry)
+		}
+	}
+}
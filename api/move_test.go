@@ -0,0 +1,127 @@
+package api
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/prgrs/clickup/pkg/cache"
+	"github.com/prgrs/clickup/pkg/clickup"
+)
+
+// moveServer keeps a single task and moves it between lists.
+type moveServer struct {
+	mutex sync.Mutex
+	task  clickup.Task
+	moves []string
+}
+
+func (s *moveServer) handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /api/v2/task/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		_ = json.NewEncoder(w).Encode(s.task)
+	})
+
+	mux.HandleFunc("PUT /api/v3/workspaces/{team}/tasks/{id}/home_list/{list}", func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		list := r.PathValue("list")
+		s.moves = append(s.moves, list)
+		s.task.List.Id = list
+		updated, _ := strconv.Atoi(s.task.DateUpdated)
+		s.task.DateUpdated = strconv.Itoa(updated + 1)
+
+		_, _ = w.Write([]byte("{}"))
+	})
+
+	return mux
+}
+
+func TestMoveTask(t *testing.T) {
+	task := testTask("1", "Task", "open", "1")
+	task.TeamId = "team"
+	task.List.Id = "a"
+
+	s := &moveServer{task: task}
+	srv := httptest.NewServer(s.handler())
+	defer srv.Close()
+
+	a := newCacheApi(t)
+	a.Clickup = clickup.NewClient("token", srv.URL+"/api/v2", slog.Default())
+
+	lists := cache.NewTyped[[]clickup.Task](a.Cache, CacheNamespaceTasksList)
+	lists.Set("a", []clickup.Task{task})
+	lists.Set("b", []clickup.Task{})
+
+	listed := func(listId string) []string {
+		tasks, err := lists.Peek(cache.Key(listId))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ids(tasks)
+	}
+
+	moved, err := a.MoveTask(task, "b")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if moved.List.Id != "b" {
+		t.Errorf("moved task list = %q, want %q", moved.List.Id, "b")
+	}
+	if got, want := listed("a"), []string{}; !slices.Equal(got, want) {
+		t.Errorf("tasks of list a = %v, want %v", got, want)
+	}
+	if got, want := listed("b"), []string{"1"}; !slices.Equal(got, want) {
+		t.Errorf("tasks of list b = %v, want %v", got, want)
+	}
+	if !a.History.CanUndo() {
+		t.Fatal("move was not recorded to be undone")
+	}
+
+	if _, err := a.Undo(false); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := s.moves, []string{"b", "a"}; !slices.Equal(got, want) {
+		t.Errorf("moves = %v, want %v", got, want)
+	}
+	if got, want := listed("a"), []string{"1"}; !slices.Equal(got, want) {
+		t.Errorf("tasks of list a after undo = %v, want %v", got, want)
+	}
+	if got, want := listed("b"), []string{}; !slices.Equal(got, want) {
+		t.Errorf("tasks of list b after undo = %v, want %v", got, want)
+	}
+}
+
+func TestMoveTaskUnknownWorkspace(t *testing.T) {
+	task := testTask("1", "Task", "open", "1")
+	task.List.Id = "a"
+
+	s := &moveServer{task: task}
+	srv := httptest.NewServer(s.handler())
+	defer srv.Close()
+
+	a := newCacheApi(t)
+	a.Clickup = clickup.NewClient("token", srv.URL+"/api/v2", slog.Default())
+
+	if _, err := a.MoveTask(task, "b"); err != ErrUnknownTaskWorkspace {
+		t.Errorf("MoveTask() error = %v, want %v", err, ErrUnknownTaskWorkspace)
+	}
+	if len(s.moves) != 0 {
+		t.Errorf("moves = %v, want none", s.moves)
+	}
+	if a.History.CanUndo() {
+		t.Error("failed move was recorded to be undone")
+	}
+}
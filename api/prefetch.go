@@ -0,0 +1,36 @@
+package api
+
+import (
+	"github.com/prgrs/clickup/pkg/cache"
+)
+
+// Prefetch fetches children of the highlighted resource in the background so
+// they are cached by the time the resource is selected. Namespace is the one
+// of the children, e.g. CacheNamespaceFolders for a space id.
+func (m *Api) Prefetch(namespace cache.Namespace, key string) {
+	if m.CheckIfCached(namespace, key) {
+		return
+	}
+
+	var fetch func(string) error
+	switch namespace {
+	case CacheNamespaceSpaces:
+		fetch = func(id string) error { _, err := m.GetSpaces(id); return err }
+	case CacheNamespaceFolders:
+		fetch = func(id string) error { _, err := m.GetFolders(id); return err }
+	case CacheNamespaceListsFolder:
+		fetch = func(id string) error { _, err := m.GetLists(id); return err }
+	case CacheNamespaceListsSpace:
+		fetch = func(id string) error { _, err := m.GetFolderlessLists(id); return err }
+	default:
+		m.logger.Warn("Prefetching is not supported", "namespace", namespace)
+		return
+	}
+
+	go func() {
+		m.logger.Debug("Prefetching", "namespace", namespace, "id", key)
+		if err := fetch(key); err != nil {
+			m.logger.Error("Failed to prefetch", "namespace", namespace, "id", key, "error", err)
+		}
+	}()
+}
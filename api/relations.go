@@ -0,0 +1,86 @@
+package api
+
+import (
+	"errors"
+
+	"github.com/prgrs/clickup/pkg/clickup"
+)
+
+// Relation is a relation between two tasks.
+type Relation int
+
+const (
+	// RelationWaitingOn tells the task is waiting on the other one.
+	RelationWaitingOn Relation = iota
+	// RelationBlocking tells the other task is waiting on the task.
+	RelationBlocking
+	// RelationLinked links both tasks.
+	RelationLinked
+)
+
+var ErrSelfRelation = errors.New("task cannot be related to itself")
+
+func (r Relation) String() string {
+	switch r {
+	case RelationWaitingOn:
+		return "waiting on"
+	case RelationBlocking:
+		return "blocking"
+	default:
+		return "linked"
+	}
+}
+
+// AddRelation relates the task to the other one and returns the updated
+// task. The other task is synced as well since it lists the relation too.
+func (m *Api) AddRelation(taskId string, otherId string, r Relation) (clickup.Task, error) {
+	m.logger.Debug("Adding a task relation", "taskId", taskId, "otherId", otherId, "relation", r)
+
+	if taskId == otherId {
+		return clickup.Task{}, ErrSelfRelation
+	}
+
+	var err error
+	switch r {
+	case RelationWaitingOn:
+		err = m.Clickup.AddDependency(taskId, clickup.RequestDependency{DependsOn: otherId})
+	case RelationBlocking:
+		err = m.Clickup.AddDependency(taskId, clickup.RequestDependency{DependencyOf: otherId})
+	case RelationLinked:
+		err = m.Clickup.AddTaskLink(taskId, otherId)
+	}
+	if err != nil {
+		return clickup.Task{}, err
+	}
+
+	return m.syncRelatedTasks(taskId, otherId)
+}
+
+// RemoveRelation removes the relation between the tasks and returns the
+// updated task.
+func (m *Api) RemoveRelation(taskId string, otherId string, r Relation) (clickup.Task, error) {
+	m.logger.Debug("Removing a task relation", "taskId", taskId, "otherId", otherId, "relation", r)
+
+	var err error
+	switch r {
+	case RelationWaitingOn:
+		err = m.Clickup.RemoveDependency(taskId, clickup.RequestDependency{DependsOn: otherId})
+	case RelationBlocking:
+		err = m.Clickup.RemoveDependency(taskId, clickup.RequestDependency{DependencyOf: otherId})
+	case RelationLinked:
+		err = m.Clickup.RemoveTaskLink(taskId, otherId)
+	}
+	if err != nil {
+		return clickup.Task{}, err
+	}
+
+	return m.syncRelatedTasks(taskId, otherId)
+}
+
+func (m *Api) syncRelatedTasks(taskId string, otherId string) (clickup.Task, error) {
+	if _, err := m.syncChangedTask(otherId); err != nil {
+		m.logger.Warn("Failed to sync the related task", "taskId", otherId, "error", err)
+	}
+
+	return m.syncChangedTask(taskId)
+}
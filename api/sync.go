@@ -0,0 +1,319 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/prgrs/clickup/pkg/cache"
+	"github.com/prgrs/clickup/pkg/clickup"
+)
+
+const (
+	// SyncConcurrency limits the number of requests sent at once while
+	// syncing.
+	SyncConcurrency = 4
+	// FullSyncInterval is how often lists of tasks are fetched in full
+	// instead of only their updated tasks. Updates miss tasks which were
+	// deleted, archived or moved to another list.
+	FullSyncInterval = time.Hour
+)
+
+// syncPriority orders namespaces by how likely their entries are on screen.
+// Namespaces that are not listed are synced last.
+var syncPriority = []cache.Namespace{
+	CacheNamespaceTasks,
+	CacheNamespaceTasksView,
+	CacheNamespaceTasksList,
+	CacheNamespaceTasksListArchived,
+	CacheNamespaceTasksAssigned,
+	CacheNamespaceViewsList,
+	CacheNamespaceViewsFolder,
+	CacheNamespaceViewsSpace,
+	CacheNamespaceViewsWorkspace,
+	CacheNamespaceLists,
+	CacheNamespaceListsFolder,
+	CacheNamespaceListsSpace,
+	CacheNamespaceListsFolderArchived,
+	CacheNamespaceListsSpaceArchived,
+	CacheNamespaceFolders,
+	CacheNamespaceFoldersArchived,
+	CacheNamespaceSpaceTags,
+	CacheNamespaceSpaces,
+	CacheNamespaceTeams,
+	CacheNamespaceUser,
+}
+
+type syncState struct {
+	mutex      sync.Mutex
+	visible    map[cache.Namespace]map[cache.Key]bool
+	synced     map[string]time.Time
+	fullSynced map[string]time.Time
+}
+
+func newSyncState() *syncState {
+	return &syncState{
+		visible:    map[cache.Namespace]map[cache.Key]bool{},
+		synced:     map[string]time.Time{},
+		fullSynced: map[string]time.Time{},
+	}
+}
+
+func (s *syncState) setVisible(namespace cache.Namespace, keys []string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	v := make(map[cache.Key]bool, len(keys))
+	for _, key := range keys {
+		v[cache.Key(key)] = true
+	}
+	s.visible[namespace] = v
+}
+
+func (s *syncState) isVisible(entry cache.Entry) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.visible[entry.Namespace][entry.Key]
+}
+
+func (s *syncState) setSynced(namespace cache.Namespace, key cache.Key, ts time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.synced[cache.Entry{Namespace: namespace, Key: key}.Id()] = ts
+}
+
+// setFullySynced records that the entry was fetched in full, not only its
+// updates.
+func (s *syncState) setFullySynced(namespace cache.Namespace, key cache.Key, ts time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	id := cache.Entry{Namespace: namespace, Key: key}.Id()
+	s.synced[id] = ts
+	s.fullSynced[id] = ts
+}
+
+func (s *syncState) lastSynced(entry cache.Entry) (time.Time, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	ts, ok := s.synced[entry.Id()]
+	return ts, ok
+}
+
+func (s *syncState) lastFullySynced(entry cache.Entry) (time.Time, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	ts, ok := s.fullSynced[entry.Id()]
+	return ts, ok
+}
+
+// SetVisible marks entries of the namespace which are on screen, so they are
+// synced before the others. It replaces previously visible keys.
+func (m *Api) SetVisible(namespace cache.Namespace, keys ...string) {
+	m.syncState.setVisible(namespace, keys)
+}
+
+// Sync refreshes stale cache entries with at most SyncConcurrency requests at
+// once. Visible entries go first, the rest in order of syncPriority.
+func (m *Api) Sync() error {
+	m.logger.Debug("Sync API")
+
+	entries := m.syncQueue()
+	errs := make([]error, len(entries))
+	errgroup := new(errgroup.Group)
+	errgroup.SetLimit(SyncConcurrency)
+
+	// Every entry is synced, the failures are returned together.
+	for i, entry := range entries {
+		func(i int, entry cache.Entry) {
+			errgroup.Go(func() error {
+				errs[i] = m.syncEntry(entry)
+				return nil
+			})
+		}(i, entry)
+	}
+
+	_ = errgroup.Wait()
+
+	return errors.Join(errs...)
+}
+
+// syncQueue returns stale entries in the order they should be synced.
+func (m *Api) syncQueue() []cache.Entry {
+	priority := make(map[cache.Namespace]int, len(syncPriority))
+	for i, namespace := range syncPriority {
+		priority[namespace] = i
+	}
+
+	rank := func(namespace cache.Namespace) int {
+		if p, ok := priority[namespace]; ok {
+			return p
+		}
+		return len(syncPriority)
+	}
+
+	queue := []cache.Entry{}
+	visible := map[string]bool{}
+	for _, entry := range m.Cache.GetEntries() {
+		if !entry.Stale {
+			continue
+		}
+		queue = append(queue, entry)
+		visible[entry.Id()] = m.syncState.isVisible(entry)
+	}
+
+	sort.SliceStable(queue, func(i, j int) bool {
+		a, b := queue[i], queue[j]
+
+		if visible[a.Id()] != visible[b.Id()] {
+			return visible[a.Id()]
+		}
+
+		if rank(a.Namespace) != rank(b.Namespace) {
+			return rank(a.Namespace) < rank(b.Namespace)
+		}
+
+		return a.AccessedTs > b.AccessedTs
+	})
+
+	return queue
+}
+
+func (m *Api) syncEntry(entry cache.Entry) error {
+	m.logger.Debug("Invalidating cache", "entry", entry.Id())
+
+	var (
+		err   error
+		tasks []clickup.Task
+		old   = m.cachedTasks(entry)
+		key   = entry.Key.String()
+	)
+
+	switch entry.Namespace {
+	case CacheNamespaceTeams:
+		_, err = m.SyncTeams()
+	case CacheNamespaceSpaces:
+		_, err = m.SyncSpaces(key)
+	case CacheNamespaceSpaceTags:
+		_, err = m.SyncSpaceTags(key)
+	case CacheNamespaceFolders:
+		_, err = m.SyncFolders(key)
+	case CacheNamespaceLists:
+		_, err = m.SyncList(key)
+	case CacheNamespaceListsFolder:
+		_, err = m.SyncLists(key)
+	case CacheNamespaceListsSpace:
+		_, err = m.SyncFolderlessLists(key)
+	case CacheNamespaceFoldersArchived:
+		_, err = m.SyncArchivedFolders(key)
+	case CacheNamespaceListsFolderArchived:
+		_, err = m.getArchivedListsFromFolder(false, key)
+	case CacheNamespaceListsSpaceArchived:
+		_, err = m.getArchivedFolderlessLists(false, key)
+	case CacheNamespaceViewsWorkspace:
+		_, err = m.syncViewsFromWorkspace(key)
+	case CacheNamespaceViewsSpace:
+		_, err = m.syncViewsFromSpace(key)
+	case CacheNamespaceViewsFolder:
+		_, err = m.syncViewsFromFolder(key)
+	case CacheNamespaceViewsList:
+		_, err = m.syncViewsFromList(key)
+	case CacheNamespaceTasksList:
+		tasks, err = m.syncTasksFromListIncremental(entry, old)
+	case CacheNamespaceTasksView:
+		tasks, err = m.SyncTasksFromView(key)
+	case CacheNamespaceTasksListArchived:
+		tasks, err = m.SyncArchivedTasksFromList(key)
+	case CacheNamespaceTasks:
+		var task clickup.Task
+		task, err = m.SyncTask(key)
+		tasks = []clickup.Task{task}
+	case CacheNamespaceTasksAssigned:
+		tasks, err = m.SyncMyTasks(key)
+	case CacheNamespaceUser:
+		_, err = m.SyncAuthorizedUser()
+	default:
+		m.logger.Warn("Removing cache entry due to invalid namespace", "entry", entry.Id(), "namespace", entry.Namespace)
+		m.Cache.Delete(entry)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to sync %s: %w", entry.Id(), err)
+	}
+
+	m.recordChanges(old, tasks)
+	return nil
+}
+
+// syncTasksFromListIncremental fetches only tasks updated since the last sync
+// of the list and merges them into the cached ones. Lists that were not synced
+// in this session yet or not in full for FullSyncInterval are fetched in full.
+func (m *Api) syncTasksFromListIncremental(entry cache.Entry, cached []clickup.Task) ([]clickup.Task, error) {
+	full, ok := m.syncState.lastFullySynced(entry)
+	if !ok || cached == nil || time.Since(full) > FullSyncInterval {
+		return m.SyncTasksFromList(entry.Key.String())
+	}
+
+	since, _ := m.syncState.lastSynced(entry)
+
+	ts := time.Now()
+	changed, err := m.Clickup.GetTasksFromListUpdatedSince(entry.Key.String(), since)
+	if err != nil {
+		return nil, err
+	}
+
+	m.logger.Debug("Merging updated tasks", "entry", entry.Id(), "count", len(changed))
+
+	tasks := mergeTasks(entry.Key.String(), cached, changed)
+	m.Cache.Set(entry.Namespace, entry.Key, tasks)
+	m.syncState.setSynced(entry.Namespace, entry.Key, ts)
+
+	return tasks, nil
+}
+
+// mergeTasks replaces tasks of the list with their changed versions and
+// appends new ones. Changed tasks which are no longer listed, because they
+// were closed, archived or moved to another list, are dropped.
+func mergeTasks(listId string, tasks []clickup.Task, changed []clickup.Task) []clickup.Task {
+	pending := make(map[string]clickup.Task, len(changed))
+	for _, t := range changed {
+		pending[t.Id] = t
+	}
+
+	merged := make([]clickup.Task, 0, len(tasks)+len(changed))
+	for _, t := range tasks {
+		if c, ok := pending[t.Id]; ok {
+			delete(pending, t.Id)
+			if !isListed(listId, c) {
+				continue
+			}
+			t = c
+		}
+		merged = append(merged, t)
+	}
+
+	for _, t := range changed {
+		if _, ok := pending[t.Id]; ok && isListed(listId, t) {
+			merged = append(merged, t)
+		}
+		delete(pending, t.Id)
+	}
+
+	return merged
+}
+
+// isListed reports whether the task is among the tasks of the list fetched in
+// full.
+func isListed(listId string, task clickup.Task) bool {
+	return task.Status.Type != clickup.StatusTypeClosed &&
+		!task.Archived &&
+		(task.List.Id == "" || task.List.Id == listId)
+}
@@ -0,0 +1,243 @@
+package api
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prgrs/clickup/pkg/cache"
+	"github.com/prgrs/clickup/pkg/clickup"
+)
+
+func ids(tasks []clickup.Task) []string {
+	s := make([]string, len(tasks))
+	for i, t := range tasks {
+		s[i] = t.Id
+	}
+
+	return s
+}
+
+func TestMergeTasks(t *testing.T) {
+	listed := func(id string, name string) clickup.Task {
+		task := testTask(id, name, "open", "1")
+		task.List.Id = "list"
+		return task
+	}
+
+	closed := listed("2", "Closed")
+	closed.Status.Type = clickup.StatusTypeClosed
+
+	archived := listed("2", "Archived")
+	archived.Archived = true
+
+	moved := listed("2", "Moved")
+	moved.List.Id = "other"
+
+	newClosed := listed("4", "New")
+	newClosed.Status.Type = clickup.StatusTypeClosed
+
+	cached := []clickup.Task{listed("1", "One"), listed("2", "Two"), listed("3", "Three")}
+
+	tests := []struct {
+		name      string
+		changed   []clickup.Task
+		want      []string
+		wantNames []string
+	}{
+		{
+			name:      "nothing changed",
+			changed:   nil,
+			want:      []string{"1", "2", "3"},
+			wantNames: []string{"One", "Two", "Three"},
+		},
+		{
+			name:      "updated task",
+			changed:   []clickup.Task{listed("2", "Renamed")},
+			want:      []string{"1", "2", "3"},
+			wantNames: []string{"One", "Renamed", "Three"},
+		},
+		{
+			name:      "new task",
+			changed:   []clickup.Task{listed("4", "Four")},
+			want:      []string{"1", "2", "3", "4"},
+			wantNames: []string{"One", "Two", "Three", "Four"},
+		},
+		{
+			name:      "closed task",
+			changed:   []clickup.Task{closed},
+			want:      []string{"1", "3"},
+			wantNames: []string{"One", "Three"},
+		},
+		{
+			name:      "archived task",
+			changed:   []clickup.Task{archived},
+			want:      []string{"1", "3"},
+			wantNames: []string{"One", "Three"},
+		},
+		{
+			name:      "moved task",
+			changed:   []clickup.Task{moved},
+			want:      []string{"1", "3"},
+			wantNames: []string{"One", "Three"},
+		},
+		{
+			name:      "new closed task",
+			changed:   []clickup.Task{newClosed},
+			want:      []string{"1", "2", "3"},
+			wantNames: []string{"One", "Two", "Three"},
+		},
+		{
+			name:      "duplicated change",
+			changed:   []clickup.Task{listed("4", "Four"), listed("4", "Four")},
+			want:      []string{"1", "2", "3", "4"},
+			wantNames: []string{"One", "Two", "Three", "Four"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged := mergeTasks("list", cached, tt.changed)
+
+			if got := ids(merged); !slices.Equal(got, tt.want) {
+				t.Errorf("ids = %v, want %v", got, tt.want)
+			}
+
+			names := make([]string, len(merged))
+			for i, task := range merged {
+				names[i] = task.Name
+			}
+			if !slices.Equal(names, tt.wantNames) {
+				t.Errorf("names = %v, want %v", names, tt.wantNames)
+			}
+		})
+	}
+
+	if got := ids(cached); !slices.Equal(got, []string{"1", "2", "3"}) {
+		t.Errorf("cached tasks were modified: %v", got)
+	}
+}
+
+// listServer serves tasks of a list and records queries of the requests.
+type listServer struct {
+	mutex   sync.Mutex
+	tasks   []clickup.Task
+	queries []url.Values
+}
+
+func (s *listServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.queries = append(s.queries, r.URL.Query())
+
+	_ = json.NewEncoder(w).Encode(clickup.RequestGetTasks{Tasks: s.tasks})
+}
+
+func (s *listServer) lastQuery() url.Values {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.queries[len(s.queries)-1]
+}
+
+func TestSyncTasksFromList(t *testing.T) {
+	task := func(id string) clickup.Task {
+		t := testTask(id, "Task "+id, "open", "1")
+		t.List.Id = "list"
+		return t
+	}
+
+	s := &listServer{tasks: []clickup.Task{task("1"), task("2")}}
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+
+	a := newCacheApi(t)
+	a.Clickup = clickup.NewClient("token", srv.URL, slog.Default())
+
+	if _, err := a.GetTasksFromList("list"); err != nil {
+		t.Fatal(err)
+	}
+	if q := s.lastQuery(); q.Has("date_updated_gt") {
+		t.Fatalf("first fetch query = %v, want a full fetch", q)
+	}
+
+	entry := cache.Entry{Namespace: CacheNamespaceTasksList, Key: "list"}
+	hits := a.Cache.Stats().Hits
+
+	// Task 2 was closed, only it is returned as updated.
+	closed := task("2")
+	closed.Status.Type = clickup.StatusTypeClosed
+	s.tasks = []clickup.Task{closed}
+
+	if err := a.syncEntry(entry); err != nil {
+		t.Fatal(err)
+	}
+
+	q := s.lastQuery()
+	if !q.Has("date_updated_gt") || q.Get("include_closed") != "true" {
+		t.Errorf("incremental sync query = %v, want updated tasks including closed", q)
+	}
+	if got := ids(a.cachedTasks(entry)); !slices.Equal(got, []string{"1"}) {
+		t.Errorf("cached tasks = %v, want the closed task dropped", got)
+	}
+
+	// Task 1 was moved to another list, which only a full sync notices.
+	a.syncState.setFullySynced(entry.Namespace, entry.Key, time.Now().Add(-2*FullSyncInterval))
+	s.tasks = []clickup.Task{task("3")}
+
+	if err := a.syncEntry(entry); err != nil {
+		t.Fatal(err)
+	}
+
+	if q := s.lastQuery(); q.Has("date_updated_gt") {
+		t.Errorf("sync query after FullSyncInterval = %v, want a full fetch", q)
+	}
+	if got := ids(a.cachedTasks(entry)); !slices.Equal(got, []string{"3"}) {
+		t.Errorf("cached tasks = %v, want [3]", got)
+	}
+
+	if got := a.Cache.Stats().Hits; got != hits {
+		t.Errorf("syncing counted %d cache hits, want none", got-hits)
+	}
+}
+
+func TestSyncReturnsAllFailures(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("not json"))
+	}))
+	defer srv.Close()
+
+	a := newCacheApi(t)
+	a.Clickup = clickup.NewClient("token", srv.URL, slog.Default())
+
+	a.Cache.Set(CacheNamespaceTasks, "1", testTask("1", "Task 1", "open", "1"))
+	a.Cache.Set(CacheNamespaceTasks, "2", testTask("2", "Task 2", "open", "1"))
+	a.Cache.Set("unknown", "key", "value")
+	for _, entry := range a.Cache.GetEntries() {
+		a.Cache.MarkStale(entry.Namespace, entry.Key)
+	}
+
+	err := a.Sync()
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok || len(joined.Unwrap()) != 2 {
+		t.Fatalf("Sync() error = %v, want the failures of both tasks", err)
+	}
+	for _, id := range []string{"tasks/1", "tasks/2"} {
+		if !strings.Contains(err.Error(), id) {
+			t.Errorf("Sync() error = %v, want the failure of %s", err, id)
+		}
+	}
+
+	if a.Cache.Has("unknown", "key") {
+		t.Error("entry of an unknown namespace was not removed")
+	}
+}
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"github.com/prgrs/clickup/pkg/cache"
+	"github.com/prgrs/clickup/pkg/clickup"
+)
+
+func (m *Api) GetSpaceTags(spaceId string) ([]clickup.TaskTag, error) {
+	return m.getSpaceTags(true, spaceId)
+}
+
+func (m *Api) SyncSpaceTags(spaceId string) ([]clickup.TaskTag, error) {
+	return m.getSpaceTags(false, spaceId)
+}
+
+func (m *Api) getSpaceTags(cached bool, spaceId string) ([]clickup.TaskTag, error) {
+	m.logger.Debug("Getting tags of a space", "spaceId", spaceId)
+
+	cacheNamespace := CacheNamespaceSpaceTags
+	key := spaceId
+	fallback := func() ([]clickup.TaskTag, error) { return m.Clickup.GetSpaceTags(key) }
+
+	return get(m, cacheNamespace, key, fallback, cached)
+}
+
+// CreateSpaceTag adds the tag to the space so it can be added to its tasks.
+func (m *Api) CreateSpaceTag(spaceId string, tag clickup.TaskTag) (clickup.TaskTag, error) {
+	m.logger.Debug("Creating a tag", "spaceId", spaceId, "name", tag.Name)
+
+	tag, err := m.Clickup.CreateSpaceTag(spaceId, tag)
+	if err != nil {
+		return clickup.TaskTag{}, err
+	}
+
+	return tag, m.invalidateKey(CacheNamespaceSpaceTags, cache.Key(spaceId))
+}
@@ -0,0 +1,345 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/prgrs/clickup/pkg/cache"
+	"github.com/prgrs/clickup/pkg/clickup"
+)
+
+const (
+	webhookMaxBodySize     = 1 << 20
+	webhookShutdownTimeout = 5 * time.Second
+)
+
+var webhookEvents = []string{
+	clickup.WebhookEventTaskCreated,
+	clickup.WebhookEventTaskUpdated,
+	clickup.WebhookEventTaskDeleted,
+}
+
+// TaskEvent is a change of a task pushed by a webhook and already applied to
+// the cache.
+type TaskEvent struct {
+	Event  string
+	TaskId string
+}
+
+// WebhookReceiver is an HTTP server handling ClickUp webhook events. The
+// server has to be reachable under the registered url, e.g. through a tunnel.
+type WebhookReceiver struct {
+	api     *Api
+	webhook clickup.Webhook
+	server  *http.Server
+	events  chan TaskEvent
+}
+
+func NewWebhookReceiver(api *Api, listen string) *WebhookReceiver {
+	r := WebhookReceiver{
+		api:    api,
+		events: make(chan TaskEvent, 64),
+	}
+
+	r.server = &http.Server{
+		Addr:              listen,
+		Handler:           &r,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	return &r
+}
+
+// Register creates a webhook for task events of the team which calls the url.
+// A webhook left for the url by a previous run, e.g. one which crashed, is
+// reused if it still delivers the events, the others are deleted.
+func (r *WebhookReceiver) Register(teamId string, url string) error {
+	r.api.logger.Info("Registering webhook", "teamId", teamId, "url", url)
+
+	webhooks, err := r.api.Clickup.GetWebhooks(teamId)
+	if err != nil {
+		return err
+	}
+
+	for _, webhook := range webhooks {
+		if webhook.Endpoint != url {
+			continue
+		}
+
+		if r.webhook.Id == "" && reusableWebhook(webhook) {
+			r.api.logger.Info("Reusing webhook", "id", webhook.Id)
+			r.webhook = webhook
+			continue
+		}
+
+		r.api.logger.Info("Deleting stale webhook", "id", webhook.Id)
+		if err := r.api.Clickup.DeleteWebhook(webhook.Id); err != nil {
+			r.api.logger.Warn("Failed to delete stale webhook", "id", webhook.Id, "error", err)
+		}
+	}
+
+	if r.webhook.Id != "" {
+		return nil
+	}
+
+	webhook, err := r.api.Clickup.CreateWebhook(teamId, clickup.RequestCreateWebhook{
+		Endpoint: url,
+		Events:   webhookEvents,
+	})
+	if err != nil {
+		return err
+	}
+	r.webhook = webhook
+
+	return nil
+}
+
+// reusableWebhook reports whether the webhook is active, delivers every task
+// event and its events can be verified.
+func reusableWebhook(webhook clickup.Webhook) bool {
+	if webhook.Secret == "" || webhook.Health.Status != clickup.WebhookStatusActive {
+		return false
+	}
+
+	for _, event := range webhookEvents {
+		if !slices.Contains(webhook.Events, event) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ListenAndServe blocks until the receiver is closed.
+func (r *WebhookReceiver) ListenAndServe() error {
+	r.api.logger.Info("Listening for webhook events", "addr", r.server.Addr)
+
+	err := r.server.ListenAndServe()
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+
+	return err
+}
+
+// Close stops the server and removes the registered webhook.
+func (r *WebhookReceiver) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), webhookShutdownTimeout)
+	defer cancel()
+
+	err := r.server.Shutdown(ctx)
+
+	if r.webhook.Id != "" {
+		r.api.logger.Info("Deleting webhook", "id", r.webhook.Id)
+		err = errors.Join(err, r.api.Clickup.DeleteWebhook(r.webhook.Id))
+	}
+
+	return err
+}
+
+// Events returns task events applied to the cache.
+func (r *WebhookReceiver) Events() <-chan TaskEvent {
+	return r.events
+}
+
+func (r *WebhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(req.Body, webhookMaxBodySize))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	// Without the secret any signature could be forged.
+	if r.webhook.Secret == "" {
+		r.api.logger.Warn("Rejecting webhook event, the webhook has no secret")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	signature := req.Header.Get(clickup.WebhookSignatureHeader)
+	if !clickup.VerifyWebhookSignature(r.webhook.Secret, body, signature) {
+		r.api.logger.Warn("Rejecting webhook event with invalid signature")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var event clickup.WebhookEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	r.api.logger.Debug("Received webhook event", "event", event.Event, "taskId", event.TaskId)
+
+	if err := r.api.applyTaskEvent(event); err != nil {
+		r.api.logger.Error("Failed to apply webhook event", "event", event.Event, "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	select {
+	case r.events <- TaskEvent{Event: event.Event, TaskId: event.TaskId}:
+	default:
+		r.api.logger.Warn("Dropping webhook event, nobody is listening", "event", event.Event)
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// applyTaskEvent updates cached tasks and the lists of tasks they belong to.
+func (m *Api) applyTaskEvent(event clickup.WebhookEvent) error {
+	switch event.Event {
+	case clickup.WebhookEventTaskCreated, clickup.WebhookEventTaskUpdated:
+		old := m.cachedTasks(cache.Entry{
+			Namespace: CacheNamespaceTasks,
+			Key:       cache.Key(event.TaskId),
+		})
+
+		task, err := m.SyncTask(event.TaskId)
+		if err != nil {
+			return err
+		}
+
+		m.recordChanges(old, []clickup.Task{task})
+		m.replaceCachedTask(task, event.Event == clickup.WebhookEventTaskCreated)
+
+	case clickup.WebhookEventTaskDeleted:
+		m.removeCachedTask(event.TaskId)
+	}
+
+	return nil
+}
+
+// replaceCachedTask updates the task in cached lists of tasks. A created task
+// is appended to its list while views and assigned tasks that may now include
+// it are dropped from the cache to be fetched again.
+func (m *Api) replaceCachedTask(task clickup.Task, created bool) {
+	m.tasksLock.Lock()
+	defer m.tasksLock.Unlock()
+
+	mayInclude := func(cache.Entry) bool { return false }
+	if created {
+		mayInclude = m.mayIncludeTask(task)
+	}
+
+	for _, entry := range m.Cache.GetEntries() {
+		if !isTasksNamespace(entry.Namespace) {
+			continue
+		}
+
+		tasks := slices.Clone(m.cachedTasks(entry))
+
+		found := false
+		for i := range tasks {
+			if tasks[i].Id == task.Id {
+				tasks[i] = task
+				found = true
+			}
+		}
+
+		switch {
+		case found:
+			m.Cache.Set(entry.Namespace, entry.Key, tasks)
+		case created && entry.Namespace == CacheNamespaceTasksList && entry.Key.String() == task.List.Id:
+			m.Cache.Set(entry.Namespace, entry.Key, append(tasks, task))
+		case mayInclude(entry):
+			m.Cache.Delete(entry)
+		}
+	}
+}
+
+// mayIncludeTask returns a filter of cached entries which tasks may include
+// the task: views of its list, folder, space and workspace, tasks of its
+// workspace assigned to the user and, if archived, archived tasks of its list.
+// Views missing from cached lists of views may belong anywhere so they are
+// included too.
+func (m *Api) mayIncludeTask(task clickup.Task) func(cache.Entry) bool {
+	parents := map[cache.Namespace]string{
+		CacheNamespaceViewsList:      task.List.Id,
+		CacheNamespaceViewsFolder:    task.Folder.Id,
+		CacheNamespaceViewsSpace:     task.Space.Id,
+		CacheNamespaceViewsWorkspace: task.TeamId,
+	}
+
+	known := map[string]bool{}
+	related := map[string]bool{}
+	for _, entry := range m.Cache.GetEntries() {
+		parent, ok := parents[entry.Namespace]
+		if !ok {
+			continue
+		}
+
+		views, err := cache.NewTyped[[]clickup.View](m.Cache, entry.Namespace).Peek(entry.Key)
+		if err != nil {
+			continue
+		}
+
+		for _, v := range views {
+			known[v.Id] = true
+			if parent != "" && entry.Key.String() == parent {
+				related[v.Id] = true
+			}
+		}
+	}
+
+	// Without the user cached any assignee may be them.
+	assigned := len(task.Assignees) > 0
+	if user, err := cache.NewTyped[clickup.User](m.Cache, CacheNamespaceUser).Peek("user"); err == nil {
+		assigned = slices.ContainsFunc(task.Assignees, func(a clickup.Assignee) bool {
+			return int(a.Id) == user.Id
+		})
+	}
+
+	return func(entry cache.Entry) bool {
+		key := entry.Key.String()
+
+		switch entry.Namespace {
+		case CacheNamespaceTasksView:
+			return related[key] || !known[key]
+		case CacheNamespaceTasksAssigned:
+			return assigned && (task.TeamId == "" || key == task.TeamId)
+		case CacheNamespaceTasksListArchived:
+			return task.Archived && key == task.List.Id
+		default:
+			return false
+		}
+	}
+}
+
+func (m *Api) removeCachedTask(taskId string) {
+	m.tasksLock.Lock()
+	defer m.tasksLock.Unlock()
+
+	for _, entry := range m.Cache.GetEntries() {
+		if entry.Namespace == CacheNamespaceTasks && entry.Key.String() == taskId {
+			m.Cache.Delete(entry)
+			continue
+		}
+
+		if !isTasksNamespace(entry.Namespace) {
+			continue
+		}
+
+		tasks := m.cachedTasks(entry)
+		filtered := make([]clickup.Task, 0, len(tasks))
+		for _, t := range tasks {
+			if t.Id != taskId {
+				filtered = append(filtered, t)
+			}
+		}
+
+		if len(filtered) != len(tasks) {
+			m.Cache.Set(entry.Namespace, entry.Key, filtered)
+		}
+	}
+}
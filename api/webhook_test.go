@@ -0,0 +1,336 @@
+package api
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/charmbracelet/log"
+	"github.com/prgrs/clickup/pkg/cache"
+	"github.com/prgrs/clickup/pkg/clickup"
+)
+
+func newCacheApi(t *testing.T) *Api {
+	t.Helper()
+
+	return &Api{
+		Cache:     cache.NewCache(slog.New(slog.NewTextHandler(io.Discard, nil)), t.TempDir()),
+		Inbox:     NewInbox(),
+		History:   NewHistory(),
+		syncState: newSyncState(),
+		logger:    log.New(io.Discard),
+	}
+}
+
+func TestReplaceCachedTaskCreated(t *testing.T) {
+	a := newCacheApi(t)
+
+	other := testTask("other", "Other", "open", "1")
+	cached := []clickup.Task{other}
+
+	a.Cache.Set(CacheNamespaceUser, "user", clickup.User{Id: 7})
+	a.Cache.Set(CacheNamespaceViewsList, "list", []clickup.View{{Id: "view-list"}})
+	a.Cache.Set(CacheNamespaceViewsList, "list-other", []clickup.View{{Id: "view-list-other"}})
+	a.Cache.Set(CacheNamespaceViewsFolder, "folder", []clickup.View{{Id: "view-folder"}})
+	a.Cache.Set(CacheNamespaceViewsSpace, "space-other", []clickup.View{{Id: "view-space-other"}})
+
+	a.Cache.Set(CacheNamespaceTasksList, "list", cached)
+	a.Cache.Set(CacheNamespaceTasksList, "list-other", cached)
+	a.Cache.Set(CacheNamespaceTasksListArchived, "list", cached)
+	a.Cache.Set(CacheNamespaceTasksView, "view-list", cached)
+	a.Cache.Set(CacheNamespaceTasksView, "view-folder", cached)
+	a.Cache.Set(CacheNamespaceTasksView, "view-list-other", cached)
+	a.Cache.Set(CacheNamespaceTasksView, "view-space-other", cached)
+	a.Cache.Set(CacheNamespaceTasksView, "view-unknown", cached)
+	a.Cache.Set(CacheNamespaceTasksAssigned, "team", cached)
+	a.Cache.Set(CacheNamespaceTasksAssigned, "team-other", cached)
+
+	task := testTask("new", "New", "open", "1")
+	task.TeamId = "team"
+	task.List.Id = "list"
+	task.Folder.Id = "folder"
+	task.Space.Id = "space"
+	task.Assignees = []clickup.Assignee{{Id: 7}}
+
+	a.replaceCachedTask(task, true)
+
+	tests := []struct {
+		namespace cache.Namespace
+		key       cache.Key
+		cached    bool
+	}{
+		{CacheNamespaceTasksList, "list", true},
+		{CacheNamespaceTasksList, "list-other", true},
+		{CacheNamespaceTasksListArchived, "list", true},
+		{CacheNamespaceTasksView, "view-list", false},
+		{CacheNamespaceTasksView, "view-folder", false},
+		{CacheNamespaceTasksView, "view-list-other", true},
+		{CacheNamespaceTasksView, "view-space-other", true},
+		{CacheNamespaceTasksView, "view-unknown", false},
+		{CacheNamespaceTasksAssigned, "team", false},
+		{CacheNamespaceTasksAssigned, "team-other", true},
+	}
+
+	for _, tt := range tests {
+		entry := cache.Entry{Namespace: tt.namespace, Key: tt.key}
+		if got := a.Cache.Has(tt.namespace, tt.key); got != tt.cached {
+			t.Errorf("%s cached = %v, want %v", entry.Id(), got, tt.cached)
+		}
+	}
+
+	tasks := a.cachedTasks(cache.Entry{Namespace: CacheNamespaceTasksList, Key: "list"})
+	if len(tasks) != 2 || tasks[1].Id != "new" {
+		t.Errorf("tasks of the list = %v, want the new task appended", tasks)
+	}
+
+	tasks = a.cachedTasks(cache.Entry{Namespace: CacheNamespaceTasksList, Key: "list-other"})
+	if len(tasks) != 1 {
+		t.Errorf("tasks of another list = %v, want unchanged", tasks)
+	}
+}
+
+func TestReplaceCachedTaskAssignedToSomeoneElse(t *testing.T) {
+	a := newCacheApi(t)
+
+	a.Cache.Set(CacheNamespaceUser, "user", clickup.User{Id: 7})
+	a.Cache.Set(CacheNamespaceTasksAssigned, "team", []clickup.Task{})
+
+	task := testTask("new", "New", "open", "1")
+	task.TeamId = "team"
+	task.Assignees = []clickup.Assignee{{Id: 8}}
+
+	a.replaceCachedTask(task, true)
+
+	if !a.Cache.Has(CacheNamespaceTasksAssigned, "team") {
+		t.Error("tasks assigned to the user were dropped for a task assigned to someone else")
+	}
+}
+
+func TestReplaceCachedTaskUpdated(t *testing.T) {
+	a := newCacheApi(t)
+
+	a.Cache.Set(CacheNamespaceTasksView, "view", []clickup.Task{testTask("1", "Old", "open", "1")})
+	a.Cache.Set(CacheNamespaceTasksView, "view-other", []clickup.Task{testTask("2", "Other", "open", "1")})
+
+	a.replaceCachedTask(testTask("1", "New", "open", "2"), false)
+
+	tasks := a.cachedTasks(cache.Entry{Namespace: CacheNamespaceTasksView, Key: "view"})
+	if len(tasks) != 1 || tasks[0].Name != "New" {
+		t.Errorf("tasks of the view = %v, want the updated task", tasks)
+	}
+
+	if !a.Cache.Has(CacheNamespaceTasksView, "view-other") {
+		t.Error("view without the updated task was dropped")
+	}
+}
+
+func TestCachedTasksDoesNotCountAccess(t *testing.T) {
+	a := newCacheApi(t)
+	a.Cache.Set(CacheNamespaceTasksList, "list", []clickup.Task{testTask("1", "Task", "open", "1")})
+
+	a.removeCachedTask("missing")
+	a.replaceCachedTask(testTask("2", "New", "open", "1"), false)
+
+	if stats := a.Cache.Stats(); stats.Hits != 0 || stats.Misses != 0 {
+		t.Errorf("hits, misses = %d, %d, want 0, 0", stats.Hits, stats.Misses)
+	}
+}
+
+func TestWebhookReceiverSignature(t *testing.T) {
+	body := []byte(`{"event": "taskDeleted", "task_id": "1"}`)
+	sign := func(secret string) string {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(body)
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+
+	tests := []struct {
+		name      string
+		secret    string
+		signature string
+		want      int
+	}{
+		{"valid signature", "secret", sign("secret"), http.StatusOK},
+		{"invalid signature", "secret", sign("other"), http.StatusUnauthorized},
+		{"missing signature", "secret", "", http.StatusUnauthorized},
+		{"no secret", "", sign(""), http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newCacheApi(t)
+			a.Cache.Set(CacheNamespaceTasks, "1", testTask("1", "Task", "open", "1"))
+
+			r := NewWebhookReceiver(a, ":0")
+			r.webhook.Secret = tt.secret
+
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+			req.Header.Set(clickup.WebhookSignatureHeader, tt.signature)
+			rec := httptest.NewRecorder()
+
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+
+			applied := !a.Cache.Has(CacheNamespaceTasks, "1")
+			if want := tt.want == http.StatusOK; applied != want {
+				t.Errorf("event applied = %v, want %v", applied, want)
+			}
+		})
+	}
+}
+
+// webhookServer keeps webhooks of a team and records the created and deleted
+// ones.
+type webhookServer struct {
+	mutex    sync.Mutex
+	webhooks []clickup.Webhook
+	created  []clickup.RequestCreateWebhook
+	deleted  []string
+}
+
+func (s *webhookServer) handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /team/{id}/webhook", func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		_ = json.NewEncoder(w).Encode(clickup.RequestGetWebhooks{Webhooks: s.webhooks})
+	})
+
+	mux.HandleFunc("POST /team/{id}/webhook", func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		var body clickup.RequestCreateWebhook
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.created = append(s.created, body)
+
+		_ = json.NewEncoder(w).Encode(clickup.ResponseCreateWebhook{
+			Id:      "new",
+			Webhook: clickup.Webhook{Id: "new", Endpoint: body.Endpoint, Secret: "new secret"},
+		})
+	})
+
+	mux.HandleFunc("DELETE /webhook/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		s.deleted = append(s.deleted, r.PathValue("id"))
+		_, _ = w.Write([]byte("{}"))
+	})
+
+	return mux
+}
+
+func TestWebhookReceiverRegister(t *testing.T) {
+	const webhookUrl = "https://example.com/webhook"
+
+	webhook := func(id string, endpoint string, status string) clickup.Webhook {
+		return clickup.Webhook{
+			Id:       id,
+			Endpoint: endpoint,
+			Secret:   id + " secret",
+			Events:   webhookEvents,
+			Health:   clickup.WebhookHealth{Status: status},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		webhooks    []clickup.Webhook
+		wantId      string
+		wantCreated bool
+		wantDeleted []string
+	}{
+		{
+			name:        "no webhooks",
+			webhooks:    nil,
+			wantId:      "new",
+			wantCreated: true,
+		},
+		{
+			name: "active webhook left by a previous run",
+			webhooks: []clickup.Webhook{
+				webhook("other", "https://example.com/other", clickup.WebhookStatusActive),
+				webhook("old", webhookUrl, clickup.WebhookStatusActive),
+				webhook("older", webhookUrl, clickup.WebhookStatusActive),
+			},
+			wantId:      "old",
+			wantDeleted: []string{"older"},
+		},
+		{
+			name: "suspended webhook",
+			webhooks: []clickup.Webhook{
+				webhook("old", webhookUrl, "suspended"),
+			},
+			wantId:      "new",
+			wantCreated: true,
+			wantDeleted: []string{"old"},
+		},
+		{
+			name: "webhook without the secret",
+			webhooks: []clickup.Webhook{
+				{Id: "old", Endpoint: webhookUrl, Events: webhookEvents, Health: clickup.WebhookHealth{Status: clickup.WebhookStatusActive}},
+			},
+			wantId:      "new",
+			wantCreated: true,
+			wantDeleted: []string{"old"},
+		},
+		{
+			name: "webhook missing events",
+			webhooks: []clickup.Webhook{
+				{Id: "old", Endpoint: webhookUrl, Secret: "secret", Events: []string{clickup.WebhookEventTaskCreated}, Health: clickup.WebhookHealth{Status: clickup.WebhookStatusActive}},
+			},
+			wantId:      "new",
+			wantCreated: true,
+			wantDeleted: []string{"old"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &webhookServer{webhooks: tt.webhooks}
+			srv := httptest.NewServer(s.handler())
+			defer srv.Close()
+
+			a := newCacheApi(t)
+			a.Clickup = clickup.NewClient("token", srv.URL, slog.Default())
+
+			r := NewWebhookReceiver(a, ":0")
+			if err := r.Register("team", webhookUrl); err != nil {
+				t.Fatal(err)
+			}
+
+			if r.webhook.Id != tt.wantId || r.webhook.Secret == "" {
+				t.Errorf("webhook = %+v, want %s with its secret", r.webhook, tt.wantId)
+			}
+			if created := len(s.created) != 0; created != tt.wantCreated {
+				t.Errorf("created webhooks = %+v, want created = %v", s.created, tt.wantCreated)
+			}
+			for _, c := range s.created {
+				if c.Endpoint != webhookUrl || !slices.Equal(c.Events, webhookEvents) {
+					t.Errorf("created webhook = %+v, want task events sent to %s", c, webhookUrl)
+				}
+			}
+			if !slices.Equal(s.deleted, tt.wantDeleted) {
+				t.Errorf("deleted webhooks = %v, want %v", s.deleted, tt.wantDeleted)
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -22,12 +24,95 @@ const (
 )
 
 type Config struct {
-	Token            string `yaml:"token"` // required
-	DefaultWorkspace string `yaml:"default_workspace"`
-	DefaultSpace     string `yaml:"default_space"`
-	DefaultFolder    string `yaml:"default_folder"`
-	DefaultList      string `yaml:"default_list"`
-	Path             string `yaml:"-"`
+	Token            string        `yaml:"token"` // required
+	DefaultWorkspace string        `yaml:"default_workspace"`
+	DefaultSpace     string        `yaml:"default_space"`
+	DefaultFolder    string        `yaml:"default_folder"`
+	DefaultList      string        `yaml:"default_list"`
+	Theme            string        `yaml:"theme,omitempty"`
+	Keybindings      Keybindings   `yaml:"keybindings,omitempty"`
+	Notifications    Notifications `yaml:"notifications,omitempty"`
+	Webhook          Webhook       `yaml:"webhook,omitempty"`
+	Cache            Cache         `yaml:"cache,omitempty"`
+	Attachments      Attachments   `yaml:"attachments,omitempty"`
+	Images           Images        `yaml:"images,omitempty"`
+	Path             string        `yaml:"-"`
+}
+
+// Notifications configures how changes of tasks detected while syncing are
+// announced besides the in-app inbox.
+type Notifications struct {
+	Desktop bool   `yaml:"desktop,omitempty"`
+	Command string `yaml:"command,omitempty"` // defaults to notify-send
+}
+
+// Keybindings overrides the default key bindings. Components are keyed by their
+// id and map an action name to the list of keys that trigger it, e.g.
+//
+//	keybindings:
+//	  preset: vim
+//	  tasks:
+//	    open_in_browser: ["o"]
+type Keybindings struct {
+	Preset     string                         `yaml:"preset,omitempty"`
+	Components map[string]map[string][]string `yaml:",inline"`
+}
+
+// Webhook configures the embedded receiver of ClickUp webhook events which
+// keeps the cache fresh without waiting for the next sync.
+type Webhook struct {
+	Enabled bool   `yaml:"enabled,omitempty"`
+	Listen  string `yaml:"listen,omitempty"` // address of the server, e.g. :8080
+	Url     string `yaml:"url,omitempty"`    // public url of the server, e.g. of a tunnel
+}
+
+// Cache limits the size of the cache. Entries not accessed for longer than
+// their TTL are removed and least recently used entries are evicted once
+// max_entries or max_bytes is exceeded, e.g.
+//
+//	cache:
+//	  max_entries: 1000
+//	  ttl: 5m
+//	  ttls:
+//	    teams: 24h
+type Cache struct {
+	MaxEntries int                      `yaml:"max_entries,omitempty"`
+	MaxBytes   int64                    `yaml:"max_bytes,omitempty"` // estimated, not exact
+	TTL        time.Duration            `yaml:"ttl,omitempty"`
+	TTLs       map[string]time.Duration `yaml:"ttls,omitempty"`
+}
+
+// Attachments configures where downloaded attachments of tasks are saved.
+type Attachments struct {
+	Dir string `yaml:"dir,omitempty"` // defaults to ~/Downloads
+}
+
+// DownloadDir returns the directory for downloaded attachments.
+func (a Attachments) DownloadDir() (string, error) {
+	if a.Dir == "" {
+		return ExpandHome("~/Downloads")
+	}
+
+	return ExpandHome(a.Dir)
+}
+
+// Images configures how images embedded in descriptions of tasks are shown.
+type Images struct {
+	Protocol string `yaml:"protocol,omitempty"` // auto, kitty, sixel or none, defaults to auto
+}
+
+// ExpandHome replaces the leading "~" of the path with the home directory.
+func ExpandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, path[1:]), nil
 }
 
 func fileExists(filename string) bool {
@@ -9,14 +9,20 @@ import (
 	"os/user"
 	"path/filepath"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/log"
 	"github.com/prgrs/clickup/api"
 	"github.com/prgrs/clickup/internal/config"
 	"github.com/prgrs/clickup/pkg/cache"
+	"github.com/prgrs/clickup/pkg/notifier"
+	"github.com/prgrs/clickup/pkg/termimg"
 	"github.com/prgrs/clickup/ui"
 	"github.com/prgrs/clickup/ui/context"
+	"github.com/prgrs/clickup/ui/keybindings"
+	"github.com/prgrs/clickup/ui/theme"
 	"github.com/spf13/pflag"
 	"golang.design/x/clipboard"
 )
@@ -48,6 +54,8 @@ var (
 		s.WriteString(fmt.Sprintf("%s - %s\n", AppName, AppDescription))
 		s.WriteString("Usage:\n")
 		s.WriteString(fmt.Sprintf("  %s [flags]\n", AppName))
+		s.WriteString(fmt.Sprintf("  %s [flags] cache stats\n", AppName))
+		s.WriteString(fmt.Sprintf("  %s [flags] cache invalidate <namespace> [key]\n", AppName))
 		s.WriteString("Flags:\n")
 		s.WriteString(flag.FlagUsages())
 		return s.String()
@@ -104,10 +112,24 @@ func main() {
 		termLogger.Fatal(err)
 	}
 
+	logger.Info("Initializing key bindings...")
+	keyBindings, err := keybindings.New(cfg.Keybindings)
+	if err != nil {
+		termLogger.Fatal(err)
+	}
+
+	logger.Info("Initializing theme...")
+	th, err := theme.Load(cfg.Theme, filepath.Dir(cfg.Path), lipgloss.ColorProfile())
+	if err != nil {
+		termLogger.Fatal(err)
+	}
+	logger.Info("Using theme", "name", th.Name)
+
 	logger.Info("Initializing cache...")
-	cache := cache.NewCache(
+	cache := cache.NewCacheWithOptions(
 		slog.New(logger.WithPrefix("Cache")),
 		*flagCachePath,
+		cacheOptions(cfg.Cache),
 	)
 
 	defer func() {
@@ -132,6 +154,13 @@ func main() {
 		termLogger.Fatal(err)
 	}
 
+	if args := flag.Args(); len(args) > 0 {
+		if err := runCommand(cache, args); err != nil {
+			termLogger.Fatal(err)
+		}
+		return
+	}
+
 	logger.Info("Initializing clipboard...")
 	if err := clipboard.Init(); err != nil {
 		termLogger.Fatal(err)
@@ -142,19 +171,110 @@ func main() {
 
 	defer api.Close()
 
+	if cfg.Notifications.Desktop {
+		logger.Info("Enabling desktop notifications...")
+		api.SetNotifier(notifier.NewCommand(cfg.Notifications.Command))
+	}
+
 	logger.Info("Initializing user context...")
-	ctx := context.NewUserContext(logger, api, cfg)
+	ctx := context.NewUserContext(logger, api, cfg, keyBindings, th)
+	output := termimg.NewOutput(os.Stdout)
+	ctx.Output = output
+
+	if cfg.Webhook.Enabled {
+		logger.Info("Initializing webhook receiver...")
+		receiver, err := initWebhook(cfg, api)
+		if err != nil {
+			termLogger.Fatal(err)
+		}
+
+		defer func() {
+			if err := receiver.Close(); err != nil {
+				logger.Error(err)
+			}
+		}()
+
+		go func() {
+			if err := receiver.ListenAndServe(); err != nil {
+				logger.Error(err)
+			}
+		}()
+
+		ctx.TaskEvents = receiver.Events()
+	}
 
 	logger.Info("Initializing main model...")
 	mainModel := ui.InitialModel(&ctx, logger)
 
+	logger.Info("Validating key bindings...")
+	if err := keyBindings.Validate(); err != nil {
+		termLogger.Fatal(err)
+	}
+
 	logger.Info("Initializing program...")
-	p := tea.NewProgram(mainModel, tea.WithAltScreen())
+	p := tea.NewProgram(mainModel, tea.WithAltScreen(), tea.WithOutput(output))
 	if _, err := p.Run(); err != nil {
 		termLogger.Fatal(err)
 	}
 }
 
+func cacheOptions(cfg config.Cache) cache.Options {
+	ttls := make(map[cache.Namespace]time.Duration, len(cfg.TTLs))
+	for namespace, ttl := range cfg.TTLs {
+		ttls[cache.Namespace(namespace)] = ttl
+	}
+
+	return cache.Options{
+		MaxEntries:   cfg.MaxEntries,
+		MaxBytes:     cfg.MaxBytes,
+		TTL:          cfg.TTL,
+		NamespaceTTL: ttls,
+	}
+}
+
+func runCommand(c *cache.Cache, args []string) error {
+	if args[0] != "cache" || len(args) < 2 {
+		return fmt.Errorf("unknown command: %s\n%s", strings.Join(args, " "), flagUsage())
+	}
+
+	switch args[1] {
+	case "stats":
+		return c.Stats().Write(os.Stdout)
+
+	case "invalidate":
+		switch len(args) {
+		case 3:
+			return c.InvalidateNamespace(cache.Namespace(args[2]))
+		case 4:
+			return c.InvalidateKey(cache.Namespace(args[2]), cache.Key(args[3]))
+		}
+	}
+
+	return fmt.Errorf("unknown command: %s\n%s", strings.Join(args, " "), flagUsage())
+}
+
+func initWebhook(cfg *config.Config, a *api.Api) (*api.WebhookReceiver, error) {
+	teamId := cfg.DefaultWorkspace
+	if teamId == "" {
+		teams, err := a.GetTeams()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(teams) == 0 {
+			return nil, fmt.Errorf("no workspace to register the webhook for")
+		}
+		teamId = teams[0].Id
+	}
+
+	receiver := api.NewWebhookReceiver(a, cfg.Webhook.Listen)
+	if err := receiver.Register(teamId, cfg.Webhook.Url); err != nil {
+		return nil, err
+	}
+
+	return receiver, nil
+}
+
 func initConfig(path string) (*config.Config, error) {
 	if path == "" {
 		usr, err := user.Current()
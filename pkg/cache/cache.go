@@ -7,8 +7,11 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/sync/errgroup"
@@ -28,11 +31,16 @@ type Entry struct {
 	Namespace Namespace   `json:"namespace"`
 	Value     interface{} `json:"value"`
 
-	Stale bool `json:"-"`
+	Stale bool  `json:"-"`
+	Size  int64 `json:"-"`
 
 	CreatedTs  int64 `json:"created_ts"`
 	AccessedTs int64 `json:"accessed_ts"`
 	UpdatedTs  int64 `json:"updated_ts"`
+
+	// version changes whenever the entry is stored so a value decoded from
+	// an older one is not written over a newer one.
+	version uint64
 }
 
 func (e Entry) Id() string {
@@ -60,6 +68,15 @@ func (k Key) String() string {
 	return string(k)
 }
 
+// Options limit how long and how many entries are kept in the cache. Zero
+// values mean no limit or the default TTL.
+type Options struct {
+	MaxEntries   int
+	MaxBytes     int64
+	TTL          time.Duration
+	NamespaceTTL map[Namespace]time.Duration
+}
+
 type Cache struct {
 	logger    *slog.Logger
 	data      map[Namespace]Data
@@ -67,15 +84,36 @@ type Cache struct {
 	mutex     sync.RWMutex
 	closeChan chan struct{}
 	interval  time.Duration
+	options   Options
+
+	// count and bytes total the entries in data to check the limits
+	// without walking all of them.
+	count int
+	bytes int64
+	// version is the last version given to a stored entry.
+	version uint64
+
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
 }
 
 func NewCache(logger *slog.Logger, path string) *Cache {
+	return NewCacheWithOptions(logger, path, Options{})
+}
+
+func NewCacheWithOptions(logger *slog.Logger, path string, options Options) *Cache {
+	if options.TTL == 0 {
+		options.TTL = TTL * time.Second
+	}
+
 	c := Cache{
 		path:      path,
 		data:      map[Namespace]Data{},
 		logger:    logger,
 		interval:  GarbageCollectorInterval * time.Second,
 		closeChan: make(chan struct{}),
+		options:   options,
 	}
 
 	go c.garbageCollector()
@@ -83,6 +121,14 @@ func NewCache(logger *slog.Logger, path string) *Cache {
 	return &c
 }
 
+func (c *Cache) ttl(namespace Namespace) int64 {
+	if ttl, ok := c.options.NamespaceTTL[namespace]; ok {
+		return int64(ttl.Seconds())
+	}
+
+	return int64(c.options.TTL.Seconds())
+}
+
 func (c *Cache) garbageCollector() {
 	ticker := time.NewTicker(c.interval)
 	defer ticker.Stop()
@@ -100,17 +146,17 @@ func (c *Cache) garbageCollector() {
 				go func(entry Entry) {
 					defer wg.Done()
 
-					if now > entry.AccessedTs+TTL {
+					if now > entry.AccessedTs+c.ttl(entry.Namespace) {
 						c.logger.Debug("Garbage Collector: deleting stale", "entry", entry.Id())
 						c.Delete(entry)
+						c.evictions.Add(1)
 
 						return
 					}
 
 					if now > entry.UpdatedTs+StaleInterval {
 						c.logger.Debug("Garbage Collector: marking as stale", "entry", entry.Id())
-						entry.Stale = true
-						c.Update(entry)
+						c.MarkStale(entry.Namespace, entry.Key)
 					}
 				}(entry)
 			}
@@ -135,16 +181,40 @@ func (c *Cache) Close() error {
 func (c *Cache) Delete(entry Entry) {
 	c.logger.Debug("Removing", "entry", entry.Id())
 	c.mutex.Lock()
-	delete(c.data[entry.Namespace], entry.Key)
+	c.remove(entry.Namespace, entry.Key)
 	c.mutex.Unlock()
 }
 
+// Update replaces the entry if it is still cached. Entries removed in the
+// meantime, e.g. by invalidation, are not brought back.
 func (c *Cache) Update(entry Entry) {
 	c.logger.Debug("Updating", "entry", entry.Id())
 	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.data[entry.Namespace][entry.Key]; !ok {
+		c.logger.Debug("Skipping update of removed entry", "entry", entry.Id())
+		return
+	}
+
 	entry.UpdatedTs = time.Now().Unix()
-	c.data[entry.Namespace][entry.Key] = entry
-	c.mutex.Unlock()
+	c.put(entry)
+}
+
+// MarkStale marks the entry as stale if it is still cached. Only the flag of
+// the current entry changes, so values set in the meantime are kept.
+func (c *Cache) MarkStale(namespace Namespace, key Key) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.data[namespace][key]
+	if !ok {
+		c.logger.Debug("Skipping marking removed entry as stale", "namespace", namespace, "key", key)
+		return
+	}
+
+	entry.Stale = true
+	c.data[namespace][key] = entry
 }
 
 func (c *Cache) Load() error {
@@ -165,7 +235,9 @@ func (c *Cache) Load() error {
 				}
 
 				c.mutex.Lock()
-				c.data[namespace] = data
+				for _, entry := range data {
+					c.put(entry)
+				}
 				c.mutex.Unlock()
 
 				return nil
@@ -173,56 +245,255 @@ func (c *Cache) Load() error {
 		}(namespace)
 	}
 
-	return errgroup.Wait()
+	if err := errgroup.Wait(); err != nil {
+		return err
+	}
+
+	c.evict()
+
+	return nil
 }
 
+// getNamespace returns the entries of the namespace creating it if missing.
+// It must be called with the mutex locked.
 func (c *Cache) getNamespace(namespace Namespace) Data {
-	c.mutex.Lock()
-
 	v, ok := c.data[namespace]
 	if !ok {
 		v = Data{}
+		c.data[namespace] = v
 	}
 
-	c.mutex.Unlock()
-
 	return v
 }
 
+// put stores the entry keeping the totals up to date. It must be called with
+// the mutex locked.
+func (c *Cache) put(entry Entry) {
+	data := c.getNamespace(entry.Namespace)
+
+	old, ok := data[entry.Key]
+	if !ok {
+		c.count++
+	}
+	c.bytes += entry.Size - old.Size
+
+	c.version++
+	entry.version = c.version
+	data[entry.Key] = entry
+}
+
+// remove deletes the entry keeping the totals up to date. It must be called
+// with the mutex locked.
+func (c *Cache) remove(namespace Namespace, key Key) bool {
+	entry, ok := c.data[namespace][key]
+	if !ok {
+		return false
+	}
+
+	c.count--
+	c.bytes -= entry.Size
+	delete(c.data[namespace], key)
+
+	return true
+}
+
 func (c *Cache) Get(namespace Namespace, key Key, target interface{}) error {
-	data := c.getNamespace(namespace)
+	entry, ok := c.lookup(namespace, key)
+	if !ok {
+		return ErrKeyNotFoundInNamespace
+	}
+
+	return c.parseData(entry, target)
+}
 
-	entry, ok := data[key]
+// Has reports whether the key is cached without counting it as an access.
+func (c *Cache) Has(namespace Namespace, key Key) bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	_, ok := c.data[namespace][key]
+	return ok
+}
+
+// lookup returns the entry and marks it as accessed now without changing its
+// update time.
+func (c *Cache) lookup(namespace Namespace, key Key) (Entry, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.data[namespace][key]
 	if !ok {
 		c.logger.Debug("Key not found in cache", "namespace", namespace, "key", key)
-		return ErrKeyNotFoundInNamespace
+		c.misses.Add(1)
+		return Entry{}, false
 	}
 
 	c.logger.Debug("Key found in cache", "namespace", namespace, "key", key)
+	c.hits.Add(1)
 
 	entry.AccessedTs = time.Now().Unix()
-	c.Update(entry)
-	return c.parseData(entry, target)
+	c.data[namespace][key] = entry
+
+	return entry, true
+}
+
+// peek returns the entry without counting it as a hit or a miss and without
+// marking it as accessed.
+func (c *Cache) peek(namespace Namespace, key Key) (Entry, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	entry, ok := c.data[namespace][key]
+	return entry, ok
+}
+
+// setValue replaces the value of the entry with its decoded form keeping its
+// timestamps. The value is dropped if the entry was stored again since it was
+// read, e.g. by Set, so the newer value is not overwritten.
+func (c *Cache) setValue(read Entry, value interface{}) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if entry, ok := c.data[read.Namespace][read.Key]; ok && entry.version == read.version {
+		entry.Value = value
+		c.data[read.Namespace][read.Key] = entry
+	}
 }
 
 func (c *Cache) Set(namespace Namespace, key Key, value interface{}) {
 	c.logger.Debug("Caching", "namespace", namespace, "key", key)
-	data := c.getNamespace(namespace)
+
+	var size int64
+	if c.options.MaxBytes > 0 {
+		size = sizeOf(value)
+	}
 
 	ts := time.Now().Unix()
 	c.mutex.Lock()
 
-	data[key] = Entry{
+	c.put(Entry{
 		Key:        key,
 		Namespace:  namespace,
 		Value:      value,
+		Size:       size,
 		AccessedTs: ts,
 		CreatedTs:  ts,
 		UpdatedTs:  ts,
-	}
-	c.data[namespace] = data
+	})
 
 	c.mutex.Unlock()
+
+	c.evict()
+}
+
+// evict removes least recently accessed entries while the cache exceeds
+// MaxEntries or MaxBytes.
+func (c *Cache) evict() {
+	if c.options.MaxEntries <= 0 && c.options.MaxBytes <= 0 {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if !c.overLimit() {
+		return
+	}
+
+	entries := make([]Entry, 0, c.count)
+	for _, data := range c.data {
+		for _, entry := range data {
+			entries = append(entries, entry)
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].AccessedTs < entries[j].AccessedTs
+	})
+
+	for _, entry := range entries {
+		if !c.overLimit() {
+			break
+		}
+
+		c.logger.Debug("Evicting least recently used", "entry", entry.Id())
+		c.remove(entry.Namespace, entry.Key)
+		c.evictions.Add(1)
+	}
+}
+
+// overLimit reports whether the cache holds more entries or bytes than
+// allowed. It must be called with the mutex locked.
+func (c *Cache) overLimit() bool {
+	return (c.options.MaxEntries > 0 && c.count > c.options.MaxEntries) ||
+		(c.options.MaxBytes > 0 && c.bytes > c.options.MaxBytes)
+}
+
+const (
+	// maxSizeDepth stops sizeOf from following pointers of cyclic values
+	// forever.
+	maxSizeDepth = 32
+	// sizeSample is the number of elements of a slice sizeOf measures, the
+	// size of the rest is extrapolated.
+	sizeSample = 32
+)
+
+// sizeOf estimates memory used by the value as the length of its strings and
+// the size of its other fields. Unlike encoding the value it does not
+// allocate and measures only a sample of long slices, e.g. lists of tasks, so
+// it is cheap enough to run on every Set.
+func sizeOf(value interface{}) int64 {
+	return sizeOfValue(reflect.ValueOf(value), 0)
+}
+
+func sizeOfValue(v reflect.Value, depth int) int64 {
+	if depth > maxSizeDepth {
+		return 0
+	}
+
+	var size int64
+
+	switch v.Kind() {
+	case reflect.Invalid:
+		return 0
+
+	case reflect.String:
+		return int64(v.Len())
+
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return 0
+		}
+		return sizeOfValue(v.Elem(), depth+1)
+
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return int64(v.Len())
+		}
+		n := min(v.Len(), sizeSample)
+		for i := 0; i < n; i++ {
+			size += sizeOfValue(v.Index(i), depth+1)
+		}
+		if n > 0 {
+			size = size * int64(v.Len()) / int64(n)
+		}
+
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			size += sizeOfValue(iter.Key(), depth+1) + sizeOfValue(iter.Value(), depth+1)
+		}
+
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			size += sizeOfValue(v.Field(i), depth+1)
+		}
+
+	default:
+		size = int64(v.Type().Size())
+	}
+
+	return size
 }
 
 func (c *Cache) Dump() error {
@@ -259,7 +530,11 @@ func (c *Cache) Invalidate() error {
 	c.logger.Debug("Invalidating all cache entries")
 
 	// Clear the in-memory cache
+	c.mutex.Lock()
 	c.data = make(map[Namespace]Data)
+	c.count = 0
+	c.bytes = 0
+	c.mutex.Unlock()
 
 	return c.clearCacheDir()
 }
@@ -291,7 +566,11 @@ func (c *Cache) Invalidate_Deprecated() error {
 	c.logger.Debug("Invalidating all cache entries")
 
 	// Clear the in-memory cache
+	c.mutex.Lock()
 	c.data = make(map[Namespace]Data)
+	c.count = 0
+	c.bytes = 0
+	c.mutex.Unlock()
 
 	// Remove subdirectories and nested files within the cache directory
 	subdirs, err := os.ReadDir(c.path)
@@ -417,9 +696,19 @@ func (c *Cache) loadFromFile(filepath string) (Entry, error) {
 	}
 	defer f.Close()
 
-	err = json.NewDecoder(f).Decode(&entry)
+	if err := json.NewDecoder(f).Decode(&entry); err != nil {
+		return entry, err
+	}
+
+	// The file already holds the encoded value, its size is a better
+	// estimate than any computed from the decoded one.
+	if c.options.MaxBytes > 0 {
+		if info, err := f.Stat(); err == nil {
+			entry.Size = info.Size()
+		}
+	}
 
-	return entry, err
+	return entry, nil
 }
 
 func (c *Cache) parseData(data Entry, target interface{}) error {
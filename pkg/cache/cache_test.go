@@ -0,0 +1,125 @@
+package cache
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+)
+
+func newTestCache(t testing.TB, options Options) *Cache {
+	t.Helper()
+
+	return NewCacheWithOptions(slog.New(slog.NewTextHandler(io.Discard, nil)), t.TempDir(), options)
+}
+
+func TestUpdateSkipsRemovedEntries(t *testing.T) {
+	tests := []struct {
+		name   string
+		remove func(c *Cache, entry Entry)
+	}{
+		{
+			name:   "deleted entry",
+			remove: func(c *Cache, entry Entry) { c.Delete(entry) },
+		},
+		{
+			name: "invalidated key",
+			remove: func(c *Cache, entry Entry) {
+				if err := c.InvalidateKey(entry.Namespace, entry.Key); err != nil {
+					t.Fatal(err)
+				}
+			},
+		},
+		{
+			name: "invalidated namespace",
+			remove: func(c *Cache, entry Entry) {
+				if err := c.InvalidateNamespace(entry.Namespace); err != nil {
+					t.Fatal(err)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestCache(t, Options{})
+			c.Set("ns", "key", "value")
+
+			entries := c.GetEntries()
+			if len(entries) != 1 {
+				t.Fatalf("got %d entries, want 1", len(entries))
+			}
+			entry := entries[0]
+
+			tt.remove(c, entry)
+
+			entry.Stale = true
+			c.Update(entry)
+
+			if c.Has("ns", "key") {
+				t.Error("removed entry was brought back by Update")
+			}
+		})
+	}
+}
+
+func TestMarkStaleKeepsNewerValue(t *testing.T) {
+	c := newTestCache(t, Options{})
+	c.Set("ns", "key", "old")
+
+	entries := c.GetEntries()
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries, want 1", len(entries))
+	}
+
+	// Set between the snapshot taken by the garbage collector and marking.
+	c.Set("ns", "key", "new")
+	c.MarkStale(entries[0].Namespace, entries[0].Key)
+
+	var got string
+	if err := c.Get("ns", "key", &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != "new" {
+		t.Errorf("value = %q, want %q", got, "new")
+	}
+
+	entries = c.GetEntries()
+	if len(entries) != 1 || !entries[0].Stale {
+		t.Errorf("entries = %+v, want the entry marked as stale", entries)
+	}
+
+	c.Delete(entries[0])
+	c.MarkStale("ns", "key")
+
+	if c.Has("ns", "key") {
+		t.Error("removed entry was brought back by MarkStale")
+	}
+}
+
+func TestInvalidateNamespace(t *testing.T) {
+	c := newTestCache(t, Options{})
+	c.Set("ns", "a", 1)
+	c.Set("ns", "b", 2)
+	c.Set("other", "a", 3)
+
+	if err := c.Dump(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.InvalidateNamespace("ns"); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Has("ns", "a") || c.Has("ns", "b") {
+		t.Error("entries of the invalidated namespace are still cached")
+	}
+	if !c.Has("other", "a") {
+		t.Error("entry of another namespace was removed")
+	}
+
+	c.Set("ns", "c", 4)
+	var v int
+	if err := c.Get("ns", "c", &v); err != nil || v != 4 {
+		t.Errorf("Get after invalidation = %d, %v, want 4", v, err)
+	}
+}
@@ -0,0 +1,174 @@
+package cache
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+	"time"
+)
+
+// Stats describes the content of the cache and how it is used since start.
+type Stats struct {
+	Hits       uint64
+	Misses     uint64
+	Evictions  uint64
+	Namespaces []NamespaceStats
+}
+
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+
+	return float64(s.Hits) / float64(total)
+}
+
+// Write prints the stats as a table.
+func (s Stats) Write(w io.Writer) error {
+	fmt.Fprintf(w, "hits: %d, misses: %d (%.0f%% hit ratio), evictions: %d\n\n",
+		s.Hits, s.Misses, 100*s.HitRatio(), s.Evictions)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAMESPACE\tENTRIES\tSTALE\tOLDEST\tNEWEST\tDISK")
+	for _, ns := range s.Namespaces {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\t%s\t%s\n",
+			ns.Namespace, ns.Entries, ns.Stale,
+			ns.OldestAge.Round(time.Second), ns.NewestAge.Round(time.Second),
+			FormatBytes(ns.DiskBytes))
+	}
+
+	return tw.Flush()
+}
+
+// FormatBytes formats the size in binary units, e.g. 1.5 KiB.
+func FormatBytes(b int64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+
+	div, exp := int64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(b)/float64(div), "KMGT"[exp])
+}
+
+// NamespaceStats describes entries of a namespace. Ages are measured since the
+// entries were fetched.
+type NamespaceStats struct {
+	Namespace Namespace
+	Entries   int
+	Stale     int
+	OldestAge time.Duration
+	NewestAge time.Duration
+	DiskBytes int64
+}
+
+// Stats returns counters and per namespace breakdown of in-memory entries and
+// their files on disk, sorted by namespace.
+func (c *Cache) Stats() Stats {
+	now := time.Now()
+	namespaces := map[Namespace]*NamespaceStats{}
+
+	for _, entry := range c.GetEntries() {
+		ns, ok := namespaces[entry.Namespace]
+		if !ok {
+			ns = &NamespaceStats{Namespace: entry.Namespace, NewestAge: -1}
+			namespaces[entry.Namespace] = ns
+		}
+
+		ns.Entries++
+		if entry.Stale {
+			ns.Stale++
+		}
+
+		age := now.Sub(time.Unix(entry.CreatedTs, 0))
+		if age > ns.OldestAge {
+			ns.OldestAge = age
+		}
+		if ns.NewestAge < 0 || age < ns.NewestAge {
+			ns.NewestAge = age
+		}
+	}
+
+	stats := Stats{
+		Hits:       c.hits.Load(),
+		Misses:     c.misses.Load(),
+		Evictions:  c.evictions.Load(),
+		Namespaces: make([]NamespaceStats, 0, len(namespaces)),
+	}
+
+	for _, ns := range namespaces {
+		ns.DiskBytes = c.diskUsage(ns.Namespace)
+		stats.Namespaces = append(stats.Namespaces, *ns)
+	}
+
+	sort.Slice(stats.Namespaces, func(i, j int) bool {
+		return stats.Namespaces[i].Namespace < stats.Namespaces[j].Namespace
+	})
+
+	return stats
+}
+
+func (c *Cache) diskUsage(namespace Namespace) int64 {
+	var size int64
+
+	_ = filepath.WalkDir(filepath.Join(c.path, string(namespace)), func(_ string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+
+		if info, err := d.Info(); err == nil {
+			size += info.Size()
+		}
+
+		return nil
+	})
+
+	return size
+}
+
+// InvalidateNamespace removes all entries of the namespace from memory and disk.
+func (c *Cache) InvalidateNamespace(namespace Namespace) error {
+	c.logger.Debug("Invalidating namespace", "namespace", namespace)
+
+	// Keep the namespace empty instead of removing it so writes to its
+	// entries never hit a nil map.
+	c.mutex.Lock()
+	for _, entry := range c.data[namespace] {
+		c.count--
+		c.bytes -= entry.Size
+	}
+	c.data[namespace] = Data{}
+	c.mutex.Unlock()
+
+	return os.RemoveAll(filepath.Join(c.path, string(namespace)))
+}
+
+// InvalidateKey removes a single entry from memory and disk.
+func (c *Cache) InvalidateKey(namespace Namespace, key Key) error {
+	c.logger.Debug("Invalidating key", "namespace", namespace, "key", key)
+
+	c.mutex.Lock()
+	ok := c.remove(namespace, key)
+	c.mutex.Unlock()
+
+	if !ok {
+		return fmt.Errorf("%w: %s/%s", ErrKeyNotFoundInNamespace, namespace, key)
+	}
+
+	err := os.Remove(filepath.Join(c.path, string(namespace), key.String()+".json"))
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	return err
+}
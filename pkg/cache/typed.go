@@ -0,0 +1,87 @@
+package cache
+
+// Typed is a view of a namespace holding values of a single type. Values are
+// kept decoded in memory and serialised only when the cache is persisted.
+// Entries loaded from disk are decoded on the first access.
+//
+// Returned values are shared with the cache so they must not be modified.
+type Typed[T any] struct {
+	cache     *Cache
+	namespace Namespace
+}
+
+func NewTyped[T any](c *Cache, namespace Namespace) *Typed[T] {
+	return &Typed[T]{
+		cache:     c,
+		namespace: namespace,
+	}
+}
+
+func (t *Typed[T]) Get(key Key) (T, error) {
+	entry, ok := t.cache.lookup(t.namespace, key)
+	if !ok {
+		var v T
+		return v, ErrKeyNotFoundInNamespace
+	}
+
+	return t.decode(entry)
+}
+
+// Peek returns the cached value like Get but neither counts it in the stats
+// nor marks it as accessed, so reading entries to keep them up to date does
+// not keep them from being evicted.
+func (t *Typed[T]) Peek(key Key) (T, error) {
+	entry, ok := t.cache.peek(t.namespace, key)
+	if !ok {
+		var v T
+		return v, ErrKeyNotFoundInNamespace
+	}
+
+	return t.decode(entry)
+}
+
+// decode returns the value of the entry decoding it once if it was loaded
+// from disk.
+func (t *Typed[T]) decode(entry Entry) (T, error) {
+	var v T
+
+	if v, ok := entry.Value.(T); ok {
+		return v, nil
+	}
+
+	if err := t.cache.parseData(entry, &v); err != nil {
+		return v, err
+	}
+	t.cache.setValue(entry, v)
+
+	return v, nil
+}
+
+func (t *Typed[T]) Set(key Key, v T) {
+	t.cache.Set(t.namespace, key, v)
+}
+
+// GetOrFetch returns the cached value or fetches and caches it if missing.
+func (t *Typed[T]) GetOrFetch(key Key, fetch func() (T, error)) (T, error) {
+	v, err := t.Get(key)
+	if err == nil {
+		return v, nil
+	}
+
+	if err != ErrKeyNotFoundInNamespace {
+		return v, err
+	}
+
+	return t.Fetch(key, fetch)
+}
+
+// Fetch caches a fresh value regardless of what is cached.
+func (t *Typed[T]) Fetch(key Key, fetch func() (T, error)) (T, error) {
+	v, err := fetch()
+	if err != nil {
+		return v, err
+	}
+	t.Set(key, v)
+
+	return v, nil
+}
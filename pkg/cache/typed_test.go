@@ -0,0 +1,371 @@
+package cache
+
+import (
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/prgrs/clickup/pkg/clickup"
+)
+
+const benchTasks = 10_000
+
+func benchTask(i int) clickup.Task {
+	id := strconv.Itoa(i)
+
+	return clickup.Task{
+		Id:                  id,
+		Name:                "Task " + id,
+		TeamId:              "team",
+		Url:                 "https://app.clickup.com/t/" + id,
+		DateCreated:         "1700000000000",
+		DateUpdated:         "1700000000000",
+		MarkdownDescription: strings.Repeat("Lorem ipsum dolor sit amet. ", 20),
+		Status:              clickup.Status{Status: "in progress"},
+		Tags:                []clickup.TaskTag{{Name: "backend"}, {Name: "bug"}},
+		Assignees:           []clickup.Assignee{{Id: uint(i), Username: "user " + id}},
+		Duedate:             "1700000000000",
+	}
+}
+
+func benchKeys() []Key {
+	keys := make([]Key, benchTasks)
+	for i := range keys {
+		keys[i] = Key(strconv.Itoa(i))
+	}
+
+	return keys
+}
+
+func TestSizeOf(t *testing.T) {
+	type nested struct {
+		Name  string
+		Tags  []string
+		Attrs map[string]string
+		Ptr   *string
+		Any   interface{}
+	}
+
+	s := "four"
+	tests := []struct {
+		name  string
+		value interface{}
+		want  int64
+	}{
+		{"nil", nil, 0},
+		{"string", "hello", 5},
+		{"bytes", []byte("hello"), 5},
+		{"int", int64(1), 8},
+		{"bool", true, 1},
+		{"slice", []string{"a", "bc"}, 3},
+		{"long slice", strings.Split(strings.Repeat("ab,", 99)+"ab", ","), 200},
+		{"map", map[string]string{"ab": "cde"}, 5},
+		{"struct", nested{
+			Name:  "ab",
+			Tags:  []string{"c", "d"},
+			Attrs: map[string]string{"e": "f"},
+			Ptr:   &s,
+			Any:   "xyz",
+		}, 13},
+		{"nil fields", nested{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sizeOf(tt.value); got != tt.want {
+				t.Errorf("sizeOf() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSizeOfCycle(t *testing.T) {
+	type node struct {
+		Name string
+		Next *node
+	}
+
+	n := &node{Name: "a"}
+	n.Next = n
+
+	if got := sizeOf(n); got <= 0 {
+		t.Errorf("sizeOf() = %d, want > 0", got)
+	}
+}
+
+func TestEvict(t *testing.T) {
+	tests := []struct {
+		name    string
+		options Options
+		want    []Key
+	}{
+		{
+			name:    "max entries",
+			options: Options{MaxEntries: 2},
+			want:    []Key{"b", "c"},
+		},
+		{
+			name:    "max bytes",
+			options: Options{MaxBytes: 10},
+			want:    []Key{"c"},
+		},
+		{
+			name:    "no limits",
+			options: Options{},
+			want:    []Key{"a", "b", "c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestCache(t, tt.options)
+
+			for i, key := range []Key{"a", "b", "c"} {
+				c.Set("ns", key, strings.Repeat("x", 6))
+				// Accessed in the order they are set.
+				c.mutex.Lock()
+				entry := c.data["ns"][key]
+				entry.AccessedTs = int64(i)
+				c.data["ns"][key] = entry
+				c.mutex.Unlock()
+			}
+
+			for _, key := range []Key{"a", "b", "c"} {
+				want := false
+				for _, k := range tt.want {
+					want = want || k == key
+				}
+
+				if got := c.Has("ns", key); got != want {
+					t.Errorf("Has(%q) = %v, want %v", key, got, want)
+				}
+			}
+
+			if c.count != len(tt.want) {
+				t.Errorf("count = %d, want %d", c.count, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestTotalsFollowChanges(t *testing.T) {
+	c := newTestCache(t, Options{MaxBytes: 1 << 20})
+
+	c.Set("ns", "a", "12345")
+	c.Set("ns", "b", "123")
+	c.Set("ns", "a", "1")
+	c.Set("other", "a", "12")
+
+	if c.count != 3 || c.bytes != 6 {
+		t.Fatalf("count, bytes = %d, %d, want 3, 6", c.count, c.bytes)
+	}
+
+	if err := c.InvalidateKey("ns", "b"); err != nil {
+		t.Fatal(err)
+	}
+	if c.count != 2 || c.bytes != 3 {
+		t.Fatalf("after InvalidateKey count, bytes = %d, %d, want 2, 3", c.count, c.bytes)
+	}
+
+	if err := c.InvalidateNamespace("ns"); err != nil {
+		t.Fatal(err)
+	}
+	if c.count != 1 || c.bytes != 2 {
+		t.Fatalf("after InvalidateNamespace count, bytes = %d, %d, want 1, 2", c.count, c.bytes)
+	}
+}
+
+func TestTypedPeek(t *testing.T) {
+	c := newTestCache(t, Options{})
+	typed := NewTyped[string](c, "ns")
+	typed.Set("key", "value")
+
+	c.mutex.Lock()
+	entry := c.data["ns"]["key"]
+	entry.AccessedTs = 1
+	c.data["ns"]["key"] = entry
+	c.mutex.Unlock()
+
+	if v, err := typed.Peek("key"); err != nil || v != "value" {
+		t.Fatalf("Peek() = %q, %v, want %q", v, err, "value")
+	}
+	if _, err := typed.Peek("missing"); err != ErrKeyNotFoundInNamespace {
+		t.Fatalf("Peek() of a missing key error = %v, want %v", err, ErrKeyNotFoundInNamespace)
+	}
+
+	stats := c.Stats()
+	if stats.Hits != 0 || stats.Misses != 0 {
+		t.Errorf("hits, misses = %d, %d, want 0, 0", stats.Hits, stats.Misses)
+	}
+	if got := c.GetEntries()[0].AccessedTs; got != 1 {
+		t.Errorf("AccessedTs = %d, want 1", got)
+	}
+
+	if _, err := typed.Get("key"); err != nil {
+		t.Fatal(err)
+	}
+	if got := c.Stats().Hits; got != 1 {
+		t.Errorf("hits after Get = %d, want 1", got)
+	}
+	if got := c.GetEntries()[0].AccessedTs; got == 1 {
+		t.Error("Get did not mark the entry as accessed")
+	}
+}
+
+func TestTypedDecodeKeepsNewerValue(t *testing.T) {
+	dir := t.TempDir()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	saved := NewCacheWithOptions(logger, dir, Options{})
+	saved.Set("ns", "key", "old")
+	saved.Set("ns", "other", "value")
+	if err := saved.Dump(); err != nil {
+		t.Fatal(err)
+	}
+
+	c := NewCacheWithOptions(logger, dir, Options{})
+	if err := c.Load(); err != nil {
+		t.Fatal(err)
+	}
+	typed := NewTyped[string](c, "ns")
+
+	// The entry is replaced while its loaded value is being decoded.
+	read, ok := c.peek("ns", "key")
+	if !ok {
+		t.Fatal("loaded entry is missing")
+	}
+	typed.Set("key", "new")
+
+	if v, err := typed.decode(read); err != nil || v != "old" {
+		t.Fatalf("decode() = %q, %v, want %q", v, err, "old")
+	}
+	if v, err := typed.Peek("key"); err != nil || v != "new" {
+		t.Errorf("Peek() = %q, %v, want %q", v, err, "new")
+	}
+
+	// An entry still cached as it was read keeps its decoded value.
+	if v, err := typed.Peek("other"); err != nil || v != "value" {
+		t.Fatalf("Peek() = %q, %v, want %q", v, err, "value")
+	}
+	if entry, _ := c.peek("ns", "other"); entry.Value != "value" {
+		t.Errorf("cached value = %#v, want the decoded one", entry.Value)
+	}
+}
+
+func BenchmarkTypedSet(b *testing.B) {
+	keys := benchKeys()
+	tasks := make([]clickup.Task, benchTasks)
+	for i := range tasks {
+		tasks[i] = benchTask(i)
+	}
+
+	c := newTestCache(b, Options{MaxEntries: benchTasks, MaxBytes: 1 << 30})
+	typed := NewTyped[clickup.Task](c, "tasks")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		typed.Set(keys[i%benchTasks], tasks[i%benchTasks])
+	}
+}
+
+func BenchmarkTypedGet(b *testing.B) {
+	keys := benchKeys()
+
+	c := newTestCache(b, Options{MaxEntries: benchTasks, MaxBytes: 1 << 30})
+	typed := NewTyped[clickup.Task](c, "tasks")
+	for i, key := range keys {
+		typed.Set(key, benchTask(i))
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := typed.Get(keys[i%benchTasks]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkTypedSetList(b *testing.B) {
+	tasks := make([]clickup.Task, benchTasks)
+	for i := range tasks {
+		tasks[i] = benchTask(i)
+	}
+
+	c := newTestCache(b, Options{MaxBytes: 1 << 30})
+	typed := NewTyped[[]clickup.Task](c, "tasks-list")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		typed.Set("list", tasks)
+	}
+}
+
+func BenchmarkTypedGetList(b *testing.B) {
+	tasks := make([]clickup.Task, benchTasks)
+	for i := range tasks {
+		tasks[i] = benchTask(i)
+	}
+
+	c := newTestCache(b, Options{MaxBytes: 1 << 30})
+	typed := NewTyped[[]clickup.Task](c, "tasks-list")
+	typed.Set("list", tasks)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := typed.Get("list"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkCacheGet is the baseline of BenchmarkTypedGet, reading through
+// the JSON round-trip of Cache.Get as values were read before Typed.
+func BenchmarkCacheGet(b *testing.B) {
+	keys := benchKeys()
+
+	c := newTestCache(b, Options{MaxEntries: benchTasks, MaxBytes: 1 << 30})
+	for i, key := range keys {
+		c.Set("tasks", key, benchTask(i))
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var task clickup.Task
+		if err := c.Get("tasks", keys[i%benchTasks], &task); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkCacheGetList is the baseline of BenchmarkTypedGetList.
+func BenchmarkCacheGetList(b *testing.B) {
+	tasks := make([]clickup.Task, benchTasks)
+	for i := range tasks {
+		tasks[i] = benchTask(i)
+	}
+
+	c := newTestCache(b, Options{MaxBytes: 1 << 30})
+	c.Set("tasks-list", "list", tasks)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var list []clickup.Task
+		if err := c.Get("tasks-list", "list", &list); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package clickup
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+)
+
+type Attachment struct {
+	Id        string      `json:"id"`
+	Date      interface{} `json:"date"`
+	Title     string      `json:"title"`
+	Extension string      `json:"extension"`
+	Mimetype  string      `json:"mimetype"`
+	Size      interface{} `json:"size"`
+	Url       string      `json:"url"`
+	User      User        `json:"user"`
+	Deleted   bool        `json:"deleted"`
+	IsFolder  bool        `json:"is_folder"`
+}
+
+// GetSize returns the size of the attachment in bytes and false if it is
+// unknown.
+func (a Attachment) GetSize() (int64, bool) {
+	return parseMillis(a.Size)
+}
+
+// UploadAttachment uploads the content as a file with the name and attaches
+// it to the task.
+func (c *Client) UploadAttachment(taskId string, filename string, content io.Reader) (Attachment, error) {
+	var objmap Attachment
+
+	endpoint := "/task/" + taskId + "/attachment"
+	errMsg := "Error occurs while uploading attachment at url: %s. Error: %s. Raw data: %s"
+
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+
+	part, err := w.CreateFormFile("attachment", filename)
+	if err != nil {
+		return objmap, err
+	}
+
+	if _, err := io.Copy(part, content); err != nil {
+		return objmap, err
+	}
+
+	if err := w.Close(); err != nil {
+		return objmap, err
+	}
+
+	rawData, err := c.send("POST", c.apiUrl+endpoint, w.FormDataContentType(), body)
+	if err != nil {
+		return objmap, fmt.Errorf(errMsg, endpoint, err, "none")
+	}
+
+	if err := checkResponseError(rawData); err != nil {
+		return objmap, fmt.Errorf(errMsg, endpoint, err, string(rawData))
+	}
+
+	if err := json.Unmarshal(rawData, &objmap); err != nil {
+		return objmap, fmt.Errorf(errMsg, endpoint, err, string(rawData))
+	}
+
+	return objmap, nil
+}
+
+// DownloadAttachment writes content of the attachment to w.
+func (c *Client) DownloadAttachment(a Attachment, w io.Writer) error {
+	body, err := c.OpenAttachment(a)
+	if err != nil {
+		return err
+	}
+
+	defer body.Close()
+
+	_, err = io.Copy(w, body)
+	return err
+}
+
+// OpenAttachment returns content of the attachment to be read and closed by
+// the caller. The url of the attachment is signed, so the token is not sent
+// with the request.
+func (c *Client) OpenAttachment(a Attachment) (io.ReadCloser, error) {
+	c.logger.Debug("Downloading attachment", "id", a.Id, "url", a.Url)
+
+	res, err := c.httpClient.Get(a.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("downloading attachment %q failed: %s", a.Title, res.Status)
+	}
+
+	return res.Body, nil
+}
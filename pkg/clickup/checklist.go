@@ -0,0 +1,80 @@
+package clickup
+
+type Checklist struct {
+	Id         string          `json:"id"`
+	TaskId     string          `json:"task_id"`
+	Name       string          `json:"name"`
+	Orderindex float64         `json:"orderindex"`
+	Resolved   int             `json:"resolved"`
+	Unresolved int             `json:"unresolved"`
+	Items      []ChecklistItem `json:"items"`
+}
+
+type ChecklistItem struct {
+	Id          string  `json:"id"`
+	Name        string  `json:"name"`
+	Orderindex  float64 `json:"orderindex"`
+	Resolved    bool    `json:"resolved"`
+	Parent      string  `json:"parent"`
+	DateCreated string  `json:"date_created"`
+}
+
+// Progress returns the number of resolved items and all items.
+func (c Checklist) Progress() (int, int) {
+	resolved := 0
+	for _, item := range c.Items {
+		if item.Resolved {
+			resolved++
+		}
+	}
+
+	return resolved, len(c.Items)
+}
+
+type RequestChecklist struct {
+	Checklist Checklist `json:"checklist"`
+}
+
+type RequestCreateChecklist struct {
+	Name string `json:"name"`
+}
+
+type RequestCreateChecklistItem struct {
+	Name string `json:"name"`
+}
+
+type RequestUpdateChecklistItem struct {
+	Resolved bool `json:"resolved"`
+}
+
+func (c *Client) CreateChecklist(taskId string, name string) (Checklist, error) {
+	var objmap RequestChecklist
+
+	if err := c.create("/task/"+taskId+"/checklist", RequestCreateChecklist{Name: name}, &objmap); err != nil {
+		return Checklist{}, err
+	}
+
+	return objmap.Checklist, nil
+}
+
+func (c *Client) CreateChecklistItem(checklistId string, name string) (Checklist, error) {
+	var objmap RequestChecklist
+
+	if err := c.create("/checklist/"+checklistId+"/checklist_item", RequestCreateChecklistItem{Name: name}, &objmap); err != nil {
+		return Checklist{}, err
+	}
+
+	return objmap.Checklist, nil
+}
+
+// SetChecklistItemResolved ticks or unticks the item.
+func (c *Client) SetChecklistItemResolved(checklistId string, itemId string, resolved bool) (Checklist, error) {
+	var objmap RequestChecklist
+
+	url := "/checklist/" + checklistId + "/checklist_item/" + itemId
+	if err := c.update(url, RequestUpdateChecklistItem{Resolved: resolved}, &objmap); err != nil {
+		return Checklist{}, err
+	}
+
+	return objmap.Checklist, nil
+}
@@ -8,10 +8,14 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 const (
 	API_URL = "https://api.clickup.com/api/v2"
+
+	apiVersion   = "/v2"
+	apiVersionV3 = "/v3"
 )
 
 type Client struct {
@@ -122,6 +126,52 @@ func (c *Client) requestPut(endpoint string, data []byte, paramsQuery ...string)
 	return io.ReadAll(res.Body)
 }
 
+func (c *Client) requestPost(endpoint string, data []byte) ([]byte, error) {
+	return c.request("POST", endpoint, data)
+}
+
+func (c *Client) requestDelete(endpoint string) ([]byte, error) {
+	return c.request("DELETE", endpoint, nil)
+}
+
+func (c *Client) request(method string, endpoint string, data []byte) ([]byte, error) {
+	return c.requestUrl(method, c.apiUrl+endpoint, data)
+}
+
+// requestV3 sends a request to an endpoint only available in the v3 API.
+func (c *Client) requestV3(method string, endpoint string, data []byte) ([]byte, error) {
+	apiUrl := strings.TrimSuffix(c.apiUrl, apiVersion) + apiVersionV3
+	return c.requestUrl(method, apiUrl+endpoint, data)
+}
+
+func (c *Client) requestUrl(method string, rawUrl string, data []byte) ([]byte, error) {
+	return c.send(method, rawUrl, "application/json", bytes.NewBuffer(data))
+}
+
+func (c *Client) send(method string, rawUrl string, contentType string, body io.Reader) ([]byte, error) {
+	reqUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("Sending "+method+" request", "request", reqUrl.String())
+	req, err := http.NewRequest(method, reqUrl.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", c.token)
+	req.Header.Add("Content-Type", contentType)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	return io.ReadAll(res.Body)
+}
+
 func (c *Client) parseQueryParams(p ...string) (string, error) {
 	if len(p)%2 != 0 {
 		return "", fmt.Errorf("invalid number of arguments")
@@ -139,11 +189,11 @@ type RequestGet interface {
 	Error() string
 }
 
-func (c *Client) get(url string, objmap RequestGet) error {
+func (c *Client) get(url string, objmap RequestGet, paramsQuery ...string) error {
 	errMsg := "Error occurs while getting resources from url: %s. Error: %s. Raw data: %s"
 	errApiMsg := errMsg + " API response: %s"
 
-	rawData, err := c.requestGet(url)
+	rawData, err := c.requestGet(url, paramsQuery...)
 	if err != nil {
 		return fmt.Errorf(errMsg, url, err, "none")
 	}
@@ -174,9 +224,81 @@ func (c *Client) update(url string, requestUpdate interface{}, objmap interface{
 		return fmt.Errorf(errMsg, url, err, "none")
 	}
 
+	if err := checkResponseError(rawData); err != nil {
+		return fmt.Errorf(errMsg, url, err, string(rawData))
+	}
+
+	if err := json.Unmarshal(rawData, objmap); err != nil {
+		return fmt.Errorf(errApiMsg, url, err, string(rawData))
+	}
+
+	return nil
+}
+
+// ResponseError is the body returned by the API when a request fails.
+type ResponseError struct {
+	Err   string `json:"err"`
+	ECode string `json:"ECODE"`
+}
+
+func (r ResponseError) Error() string {
+	return r.Err
+}
+
+func (c *Client) create(url string, requestCreate interface{}, objmap interface{}) error {
+	errMsg := "Error occurs while creating resources at url: %s. Error: %s. Raw data: %s"
+	errApiMsg := errMsg + " API response: %s"
+
+	requestJson, err := json.Marshal(requestCreate)
+	if err != nil {
+		return err
+	}
+
+	rawData, err := c.requestPost(url, requestJson)
+	if err != nil {
+		return fmt.Errorf(errMsg, url, err, "none")
+	}
+
+	if err := checkResponseError(rawData); err != nil {
+		return fmt.Errorf(errMsg, url, err, string(rawData))
+	}
+
+	if objmap == nil {
+		return nil
+	}
+
 	if err := json.Unmarshal(rawData, objmap); err != nil {
 		return fmt.Errorf(errApiMsg, url, err, string(rawData))
 	}
 
 	return nil
 }
+
+func (c *Client) delete(url string) error {
+	errMsg := "Error occurs while deleting resources at url: %s. Error: %s. Raw data: %s"
+
+	rawData, err := c.requestDelete(url)
+	if err != nil {
+		return fmt.Errorf(errMsg, url, err, "none")
+	}
+
+	if err := checkResponseError(rawData); err != nil {
+		return fmt.Errorf(errMsg, url, err, string(rawData))
+	}
+
+	return nil
+}
+
+func checkResponseError(rawData []byte) error {
+	var r ResponseError
+	if err := json.Unmarshal(rawData, &r); err != nil {
+		// not every successful response is a JSON object
+		return nil
+	}
+
+	if r.Err != "" {
+		return r
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package clickup
+
+import "net/url"
+
+// TaskDependency tells that the task TaskId is waiting on the task DependsOn.
+// Both tasks list the dependency.
+type TaskDependency struct {
+	TaskId      string `json:"task_id"`
+	DependsOn   string `json:"depends_on"`
+	Type        int    `json:"type"`
+	DateCreated string `json:"date_created"`
+	Userid      string `json:"userid"`
+}
+
+// TaskLink links the task TaskId with the task LinkId. Both tasks list the
+// link.
+type TaskLink struct {
+	TaskId      string `json:"task_id"`
+	LinkId      string `json:"link_id"`
+	DateCreated string `json:"date_created"`
+	Userid      string `json:"userid"`
+}
+
+// WaitingOn returns ids of tasks the task is waiting on.
+func (t Task) WaitingOn() []string {
+	ids := []string{}
+	for _, d := range t.Dependencies {
+		if d.TaskId == t.Id {
+			ids = append(ids, d.DependsOn)
+		}
+	}
+
+	return ids
+}
+
+// Blocking returns ids of tasks waiting on the task.
+func (t Task) Blocking() []string {
+	ids := []string{}
+	for _, d := range t.Dependencies {
+		if d.DependsOn == t.Id {
+			ids = append(ids, d.TaskId)
+		}
+	}
+
+	return ids
+}
+
+// Linked returns ids of tasks linked with the task.
+func (t Task) Linked() []string {
+	ids := []string{}
+	for _, l := range t.LinkedTasks {
+		if l.TaskId == t.Id {
+			ids = append(ids, l.LinkId)
+		} else {
+			ids = append(ids, l.TaskId)
+		}
+	}
+
+	return ids
+}
+
+// IsBlocked reports whether the task is waiting on other tasks.
+func (t Task) IsBlocked() bool {
+	return len(t.WaitingOn()) > 0
+}
+
+// RequestDependency sets either the task the task is waiting on or the task
+// waiting on it.
+type RequestDependency struct {
+	DependsOn    string `json:"depends_on,omitempty"`
+	DependencyOf string `json:"dependency_of,omitempty"`
+}
+
+func (c *Client) AddDependency(taskId string, r RequestDependency) error {
+	return c.create("/task/"+taskId+"/dependency", r, nil)
+}
+
+func (c *Client) RemoveDependency(taskId string, r RequestDependency) error {
+	query := url.Values{}
+	if r.DependsOn != "" {
+		query.Set("depends_on", r.DependsOn)
+	}
+	if r.DependencyOf != "" {
+		query.Set("dependency_of", r.DependencyOf)
+	}
+
+	return c.delete("/task/" + taskId + "/dependency?" + query.Encode())
+}
+
+func (c *Client) AddTaskLink(taskId string, linksTo string) error {
+	return c.create("/task/"+taskId+"/link/"+linksTo, struct{}{}, nil)
+}
+
+func (c *Client) RemoveTaskLink(taskId string, linksTo string) error {
+	return c.delete("/task/" + taskId + "/link/" + linksTo)
+}
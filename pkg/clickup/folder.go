@@ -9,6 +9,7 @@ type Folder struct {
 	OrderIndex       int           `json:"orderindex"`
 	OverrideStatuses bool          `json:"override_statuses"`
 	Hidden           bool          `json:"hidden"`
+	Archived         bool          `json:"archived"`
 }
 
 type FolderSpace struct {
@@ -30,11 +31,45 @@ func (c *Client) GetFolders(spaceId string) ([]Folder, error) {
 	return c.getFolders("/space/" + spaceId + "/folder")
 }
 
-func (c *Client) getFolders(url string) ([]Folder, error) {
+// GetArchivedFolders returns only archived folders of the space.
+func (c *Client) GetArchivedFolders(spaceId string) ([]Folder, error) {
+	return c.getFolders("/space/"+spaceId+"/folder", "archived", "true")
+}
+
+func (c *Client) getFolders(url string, paramsQuery ...string) ([]Folder, error) {
 	var objmap RequestGetFolders
-	if err := c.get(url, &objmap); err != nil {
+	if err := c.get(url, &objmap, paramsQuery...); err != nil {
 		return nil, err
 	}
 
 	return objmap.Folders, nil
 }
+
+// RequestFolder is the body of requests creating and renaming a folder.
+type RequestFolder struct {
+	Name string `json:"name"`
+}
+
+func (c *Client) CreateFolder(spaceId string, r RequestFolder) (Folder, error) {
+	var objmap Folder
+
+	if err := c.create("/space/"+spaceId+"/folder", r, &objmap); err != nil {
+		return objmap, err
+	}
+
+	return objmap, nil
+}
+
+func (c *Client) UpdateFolder(folderId string, r RequestFolder) (Folder, error) {
+	var objmap Folder
+
+	if err := c.update("/folder/"+folderId, r, &objmap); err != nil {
+		return objmap, err
+	}
+
+	return objmap, nil
+}
+
+func (c *Client) DeleteFolder(folderId string) error {
+	return c.delete("/folder/" + folderId)
+}
@@ -47,9 +47,25 @@ func (c *Client) GetListsFromFolder(folderId string) ([]List, error) {
 	return c.getLists("/folder/" + folderId + "/list")
 }
 
-func (c *Client) getLists(url string) ([]List, error) {
+// GetFolderlessLists returns lists of the space which are not in any folder.
+func (c *Client) GetFolderlessLists(spaceId string) ([]List, error) {
+	return c.getLists("/space/" + spaceId + "/list")
+}
+
+// GetArchivedListsFromFolder returns only archived lists of the folder.
+func (c *Client) GetArchivedListsFromFolder(folderId string) ([]List, error) {
+	return c.getLists("/folder/"+folderId+"/list", "archived", "true")
+}
+
+// GetArchivedFolderlessLists returns only archived lists of the space which
+// are not in any folder.
+func (c *Client) GetArchivedFolderlessLists(spaceId string) ([]List, error) {
+	return c.getLists("/space/"+spaceId+"/list", "archived", "true")
+}
+
+func (c *Client) getLists(url string, paramsQuery ...string) ([]List, error) {
 	var objmap RequestGetLists
-	if err := c.get(url, &objmap); err != nil {
+	if err := c.get(url, &objmap, paramsQuery...); err != nil {
 		return nil, err
 	}
 	return objmap.Lists, nil
@@ -78,3 +94,59 @@ func (c *Client) GetList(listId string) (List, error) {
 
 	return objmap, nil
 }
+
+// RequestArchive is the body of an update which only archives or unarchives
+// the resource. Unlike in RequestPutTask, false is sent as well.
+type RequestArchive struct {
+	Archived bool `json:"archived"`
+}
+
+// SetListArchived archives or unarchives the list.
+func (c *Client) SetListArchived(listId string, archived bool) (List, error) {
+	var objmap List
+
+	if err := c.update("/list/"+listId, RequestArchive{Archived: archived}, &objmap); err != nil {
+		return objmap, err
+	}
+
+	return objmap, nil
+}
+
+// RequestList is the body of requests creating and renaming a list.
+type RequestList struct {
+	Name string `json:"name"`
+}
+
+func (c *Client) CreateList(folderId string, r RequestList) (List, error) {
+	return c.createList("/folder/"+folderId+"/list", r)
+}
+
+// CreateFolderlessList creates a list in the space which is not in any
+// folder.
+func (c *Client) CreateFolderlessList(spaceId string, r RequestList) (List, error) {
+	return c.createList("/space/"+spaceId+"/list", r)
+}
+
+func (c *Client) createList(url string, r RequestList) (List, error) {
+	var objmap List
+
+	if err := c.create(url, r, &objmap); err != nil {
+		return objmap, err
+	}
+
+	return objmap, nil
+}
+
+func (c *Client) UpdateList(listId string, r RequestList) (List, error) {
+	var objmap List
+
+	if err := c.update("/list/"+listId, r, &objmap); err != nil {
+		return objmap, err
+	}
+
+	return objmap, nil
+}
+
+func (c *Client) DeleteList(listId string) error {
+	return c.delete("/list/" + listId)
+}
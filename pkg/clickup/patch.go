@@ -0,0 +1,202 @@
+package clickup
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+// TaskField is a field of a task that can be updated, named after its key in
+// the request body.
+type TaskField string
+
+const (
+	TaskFieldName                TaskField = "name"
+	TaskFieldDescription         TaskField = "description"
+	TaskFieldMarkdownDescription TaskField = "markdown_description"
+	TaskFieldStatus              TaskField = "status"
+	TaskFieldPriority            TaskField = "priority"
+	TaskFieldDueDate             TaskField = "due_date"
+	TaskFieldStartDate           TaskField = "start_date"
+	TaskFieldTimeEstimate        TaskField = "time_estimate"
+	TaskFieldPoints              TaskField = "points"
+	TaskFieldAssignees           TaskField = "assignees"
+	TaskFieldArchived            TaskField = "archived"
+	// TaskFieldTags is not a part of the request body as tags are added and
+	// removed through their own endpoints.
+	TaskFieldTags TaskField = "tags"
+	// TaskFieldList is not a part of the request body either as tasks are
+	// moved to another list through their own endpoint.
+	TaskFieldList TaskField = "list"
+)
+
+// TaskPatch updates only the masked fields of a task with values taken from
+// Task. Empty values are sent as null so they clear the field, e.g. a task
+// without a due date.
+type TaskPatch struct {
+	Task   Task
+	Fields []TaskField
+	// Assignees are added and removed if TaskFieldAssignees is masked.
+	Assignees Assignees
+}
+
+func NewTaskPatch(task Task, fields ...TaskField) TaskPatch {
+	return TaskPatch{
+		Task:   task,
+		Fields: fields,
+	}
+}
+
+func (p TaskPatch) Has(field TaskField) bool {
+	return slices.Contains(p.Fields, field)
+}
+
+func (p TaskPatch) MarshalJSON() ([]byte, error) {
+	t := p.Task
+	body := map[TaskField]interface{}{}
+
+	for _, field := range p.Fields {
+		switch field {
+		case TaskFieldName:
+			body[field] = t.Name
+		case TaskFieldDescription:
+			body[field] = t.Description
+		case TaskFieldMarkdownDescription:
+			body[field] = t.MarkdownDescription
+		case TaskFieldStatus:
+			body[field] = t.Status.Status
+		case TaskFieldPriority:
+			body[field] = nullIfZero(t.Priority.Level())
+		case TaskFieldDueDate:
+			due, ok := t.GetDueDate()
+			body[field] = timestampOrNull(due, ok)
+			body["due_date_time"] = ok && hasTimeOfDay(due)
+		case TaskFieldStartDate:
+			start, ok := t.GetStartDate()
+			body[field] = timestampOrNull(start, ok)
+			body["start_date_time"] = ok && hasTimeOfDay(start)
+		case TaskFieldTimeEstimate:
+			estimate, _ := t.GetTimeEstimate()
+			body[field] = nullIfZero(estimate.Milliseconds())
+		case TaskFieldPoints:
+			body[field] = t.Points
+		case TaskFieldAssignees:
+			body[field] = p.Assignees
+		case TaskFieldArchived:
+			body[field] = t.Archived
+		}
+	}
+
+	return json.Marshal(body)
+}
+
+func nullIfZero[T comparable](v T) interface{} {
+	var zero T
+	if v == zero {
+		return nil
+	}
+
+	return v
+}
+
+func timestampOrNull(t time.Time, ok bool) interface{} {
+	if !ok {
+		return nil
+	}
+
+	return t.UnixMilli()
+}
+
+// hasTimeOfDay reports whether the date is not at local midnight, which is
+// how dates without a time of day are stored.
+func hasTimeOfDay(t time.Time) bool {
+	t = t.Local()
+	return t.Hour() != 0 || t.Minute() != 0 || t.Second() != 0
+}
+
+// ChangedFields returns fields that differ between the tasks. The plain
+// description is compared only if the markdown one is equal.
+func ChangedFields(old Task, new Task) []TaskField {
+	fields := []TaskField{}
+	changed := func(field TaskField, ok bool) {
+		if !ok {
+			fields = append(fields, field)
+		}
+	}
+
+	oldDue, _ := old.GetDueDate()
+	newDue, _ := new.GetDueDate()
+	oldStart, _ := old.GetStartDate()
+	newStart, _ := new.GetStartDate()
+	oldEstimate, _ := old.GetTimeEstimate()
+	newEstimate, _ := new.GetTimeEstimate()
+
+	changed(TaskFieldName, old.Name == new.Name)
+	changed(TaskFieldMarkdownDescription, old.MarkdownDescription == new.MarkdownDescription)
+	if old.MarkdownDescription == new.MarkdownDescription {
+		changed(TaskFieldDescription, old.Description == new.Description)
+	}
+	changed(TaskFieldStatus, old.Status.Status == new.Status.Status)
+	changed(TaskFieldPriority, old.Priority.Level() == new.Priority.Level())
+	changed(TaskFieldDueDate, oldDue.Equal(newDue))
+	changed(TaskFieldStartDate, oldStart.Equal(newStart))
+	changed(TaskFieldTimeEstimate, oldEstimate == newEstimate)
+	changed(TaskFieldPoints, old.Points == new.Points)
+	changed(TaskFieldAssignees, slices.EqualFunc(old.Assignees, new.Assignees, func(a, b Assignee) bool {
+		return a.Id == b.Id
+	}))
+	changed(TaskFieldTags, slices.EqualFunc(old.Tags, new.Tags, func(a, b TaskTag) bool {
+		return a.Name == b.Name
+	}))
+	changed(TaskFieldArchived, old.Archived == new.Archived)
+	changed(TaskFieldList, old.List.Id == new.List.Id)
+
+	return fields
+}
+
+// CopyFields returns dst with the fields set to values of src.
+func CopyFields(dst Task, src Task, fields ...TaskField) Task {
+	for _, field := range fields {
+		switch field {
+		case TaskFieldName:
+			dst.Name = src.Name
+		case TaskFieldDescription:
+			dst.Description = src.Description
+		case TaskFieldMarkdownDescription:
+			dst.MarkdownDescription = src.MarkdownDescription
+		case TaskFieldStatus:
+			dst.Status = src.Status
+		case TaskFieldPriority:
+			dst.Priority = src.Priority
+		case TaskFieldDueDate:
+			dst.Duedate = src.Duedate
+		case TaskFieldStartDate:
+			dst.Startdate = src.Startdate
+		case TaskFieldTimeEstimate:
+			dst.Timeestimate = src.Timeestimate
+		case TaskFieldPoints:
+			dst.Points = src.Points
+		case TaskFieldAssignees:
+			dst.Assignees = src.Assignees
+		case TaskFieldTags:
+			dst.Tags = src.Tags
+		case TaskFieldArchived:
+			dst.Archived = src.Archived
+		case TaskFieldList:
+			dst.List = src.List
+		}
+	}
+
+	return dst
+}
+
+// PatchTask updates fields of the task masked by the patch.
+func (c *Client) PatchTask(taskId string, p TaskPatch) (Task, error) {
+	var objmap Task
+
+	if err := c.update("/task/"+taskId, p, &objmap); err != nil {
+		return objmap, err
+	}
+
+	return objmap, nil
+}
@@ -0,0 +1,217 @@
+package clickup
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestPatchTask(t *testing.T) {
+	millis := func(t time.Time) string {
+		return strconv.FormatInt(t.UnixMilli(), 10)
+	}
+
+	midnight := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.Local)
+	afternoon := time.Date(2024, time.March, 1, 15, 30, 0, 0, time.Local)
+
+	full := Task{
+		Id:                  "1",
+		Name:                "Name",
+		MarkdownDescription: "**Description**",
+		Description:         "Description",
+		Status:              Status{Status: "in progress"},
+		Priority:            TaskPriority{Id: "2", Priority: "high"},
+		Duedate:             millis(afternoon),
+		Startdate:           millis(midnight),
+		Timeestimate:        "5400000",
+		Points:              3,
+		Archived:            true,
+		Tags:                []TaskTag{{Name: "tag"}},
+		List:                TaskList{Id: "list"},
+	}
+
+	tests := []struct {
+		name      string
+		task      Task
+		fields    []TaskField
+		assignees Assignees
+		// want holds the expected body as raw JSON values.
+		want map[string]string
+	}{
+		{
+			name:   "no fields",
+			task:   full,
+			fields: nil,
+			want:   map[string]string{},
+		},
+		{
+			name:   "only masked fields",
+			task:   full,
+			fields: []TaskField{TaskFieldName, TaskFieldStatus},
+			want: map[string]string{
+				"name":   `"Name"`,
+				"status": `"in progress"`,
+			},
+		},
+		{
+			name:   "descriptions",
+			task:   full,
+			fields: []TaskField{TaskFieldMarkdownDescription, TaskFieldDescription},
+			want: map[string]string{
+				"markdown_description": `"**Description**"`,
+				"description":          `"Description"`,
+			},
+		},
+		{
+			name:   "cleared description",
+			task:   Task{Id: "1"},
+			fields: []TaskField{TaskFieldMarkdownDescription},
+			want: map[string]string{
+				"markdown_description": `""`,
+			},
+		},
+		{
+			name:   "priority",
+			task:   full,
+			fields: []TaskField{TaskFieldPriority},
+			want: map[string]string{
+				"priority": `2`,
+			},
+		},
+		{
+			name:   "cleared priority",
+			task:   Task{Id: "1"},
+			fields: []TaskField{TaskFieldPriority},
+			want: map[string]string{
+				"priority": `null`,
+			},
+		},
+		{
+			name:   "dates with and without time of day",
+			task:   full,
+			fields: []TaskField{TaskFieldDueDate, TaskFieldStartDate},
+			want: map[string]string{
+				"due_date":        millis(afternoon),
+				"due_date_time":   `true`,
+				"start_date":      millis(midnight),
+				"start_date_time": `false`,
+			},
+		},
+		{
+			name:   "cleared dates",
+			task:   Task{Id: "1"},
+			fields: []TaskField{TaskFieldDueDate, TaskFieldStartDate},
+			want: map[string]string{
+				"due_date":        `null`,
+				"due_date_time":   `false`,
+				"start_date":      `null`,
+				"start_date_time": `false`,
+			},
+		},
+		{
+			name:   "time estimate",
+			task:   full,
+			fields: []TaskField{TaskFieldTimeEstimate},
+			want: map[string]string{
+				"time_estimate": `5400000`,
+			},
+		},
+		{
+			name:   "cleared time estimate",
+			task:   Task{Id: "1"},
+			fields: []TaskField{TaskFieldTimeEstimate},
+			want: map[string]string{
+				"time_estimate": `null`,
+			},
+		},
+		{
+			name:   "points and archived",
+			task:   full,
+			fields: []TaskField{TaskFieldPoints, TaskFieldArchived},
+			want: map[string]string{
+				"points":   `3`,
+				"archived": `true`,
+			},
+		},
+		{
+			name:   "cleared points and unarchived",
+			task:   Task{Id: "1"},
+			fields: []TaskField{TaskFieldPoints, TaskFieldArchived},
+			want: map[string]string{
+				"points":   `0`,
+				"archived": `false`,
+			},
+		},
+		{
+			name:      "assignees",
+			task:      full,
+			fields:    []TaskField{TaskFieldAssignees},
+			assignees: Assignees{Add: []int{1}, Rem: []int{2}},
+			want: map[string]string{
+				"assignees": `{"add":[1],"rem":[2]}`,
+			},
+		},
+		{
+			name:   "tags and list are not sent",
+			task:   full,
+			fields: []TaskField{TaskFieldTags, TaskFieldList, TaskFieldName},
+			want: map[string]string{
+				"name": `"Name"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				method string
+				path   string
+				body   map[string]json.RawMessage
+			)
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				method, path = r.Method, r.URL.Path
+
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				_, _ = w.Write([]byte(`{"id": "1"}`))
+			}))
+			defer srv.Close()
+
+			c := NewClient("token", srv.URL, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+			patch := NewTaskPatch(tt.task, tt.fields...)
+			patch.Assignees = tt.assignees
+
+			if _, err := c.PatchTask("1", patch); err != nil {
+				t.Fatal(err)
+			}
+
+			if method != http.MethodPut || path != "/task/1" {
+				t.Errorf("request = %s %s, want PUT /task/1", method, path)
+			}
+
+			if len(body) != len(tt.want) {
+				t.Errorf("body = %s, want %d fields", body, len(tt.want))
+			}
+			for key, want := range tt.want {
+				got, ok := body[key]
+				if !ok {
+					t.Errorf("%s is missing in the body", key)
+					continue
+				}
+				if string(got) != want {
+					t.Errorf("%s = %s, want %s", key, got, want)
+				}
+			}
+		})
+	}
+}
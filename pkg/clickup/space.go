@@ -36,3 +36,18 @@ func (c *Client) getSpaces(url string) ([]Space, error) {
 	}
 	return objmap.Spaces, nil
 }
+
+type RequestCreateSpace struct {
+	Name              string `json:"name"`
+	MultipleAssignees bool   `json:"multiple_assignees"`
+}
+
+func (c *Client) CreateSpace(teamId string, r RequestCreateSpace) (Space, error) {
+	var objmap Space
+
+	if err := c.create("/team/"+teamId+"/space", r, &objmap); err != nil {
+		return objmap, err
+	}
+
+	return objmap, nil
+}
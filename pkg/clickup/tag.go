@@ -0,0 +1,53 @@
+package clickup
+
+import "strings"
+
+type RequestGetTags struct {
+	Tags []TaskTag `json:"tags"`
+	Err  string    `json:"err"`
+}
+
+func (r RequestGetTags) Error() string {
+	return r.Err
+}
+
+type RequestCreateTag struct {
+	Tag RequestTag `json:"tag"`
+}
+
+type RequestTag struct {
+	Name   string `json:"name"`
+	Tag_fg string `json:"tag_fg"`
+	Tag_bg string `json:"tag_bg"`
+}
+
+// GetSpaceTags returns tags that can be added to tasks of the space.
+func (c *Client) GetSpaceTags(spaceId string) ([]TaskTag, error) {
+	var objmap RequestGetTags
+
+	if err := c.get("/space/"+spaceId+"/tag", &objmap); err != nil {
+		return nil, err
+	}
+
+	return objmap.Tags, nil
+}
+
+// CreateSpaceTag adds the tag to the space. Tag names are lowercase in
+// ClickUp, so the name is lowercased.
+func (c *Client) CreateSpaceTag(spaceId string, tag TaskTag) (TaskTag, error) {
+	tag.Name = strings.ToLower(tag.Name)
+
+	r := RequestCreateTag{
+		Tag: RequestTag{
+			Name:   tag.Name,
+			Tag_fg: tag.Tag_fg,
+			Tag_bg: tag.Tag_bg,
+		},
+	}
+
+	if err := c.create("/space/"+spaceId+"/tag", r, nil); err != nil {
+		return TaskTag{}, err
+	}
+
+	return tag, nil
+}
@@ -3,37 +3,145 @@ package clickup
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type Task struct {
-	Startdate           interface{}   `json:"start_date"`
-	Duedate             interface{}   `json:"due_date"`
-	Priority            string        `json:"priority"`
-	Parent              interface{}   `json:"parent"`
-	Timeestimate        interface{}   `json:"time_estimate"`
-	Timespent           interface{}   `json:"time_spent"`
-	DateCreated         string        `json:"date_created"`
-	Orderindex          string        `json:"orderindex"`
-	Id                  string        `json:"id"`
-	DateUpdated         string        `json:"date_updated"`
-	DateClosed          string        `json:"date_closed"`
-	DateDone            string        `json:"date_done"`
-	Url                 string        `json:"url"`
-	Space               TaskSpace     `json:"space"`
-	MarkdownDescription string        `json:"markdown_description"`
-	Description         string        `json:"description"`
-	TextContent         string        `json:"text_content"`
-	Name                string        `json:"name"`
-	CustomId            string        `json:"custom_id"`
-	Points              int           `json:"points"`
-	Status              Status        `json:"status"`
-	Creator             Creator       `json:"creator"`
-	List                TaskList      `json:"list"`
-	Folder              TaskFolder    `json:"folder"`
-	Tags                []TaskTag     `json:"tags"`
-	Checklists          []interface{} `json:"checklists"`
-	Assignees           []Assignee    `json:"assignees"`
+	Startdate           interface{}      `json:"start_date"`
+	Duedate             interface{}      `json:"due_date"`
+	Priority            TaskPriority     `json:"priority"`
+	Parent              interface{}      `json:"parent"`
+	Timeestimate        interface{}      `json:"time_estimate"`
+	Timespent           interface{}      `json:"time_spent"`
+	DateCreated         string           `json:"date_created"`
+	Orderindex          string           `json:"orderindex"`
+	Id                  string           `json:"id"`
+	TeamId              string           `json:"team_id"`
+	DateUpdated         string           `json:"date_updated"`
+	DateClosed          string           `json:"date_closed"`
+	DateDone            string           `json:"date_done"`
+	Url                 string           `json:"url"`
+	Space               TaskSpace        `json:"space"`
+	MarkdownDescription string           `json:"markdown_description"`
+	Description         string           `json:"description"`
+	TextContent         string           `json:"text_content"`
+	Name                string           `json:"name"`
+	CustomId            string           `json:"custom_id"`
+	Points              int              `json:"points"`
+	Archived            bool             `json:"archived"`
+	Status              Status           `json:"status"`
+	Creator             Creator          `json:"creator"`
+	List                TaskList         `json:"list"`
+	Folder              TaskFolder       `json:"folder"`
+	Tags                []TaskTag        `json:"tags"`
+	Checklists          []Checklist      `json:"checklists"`
+	Dependencies        []TaskDependency `json:"dependencies"`
+	LinkedTasks         []TaskLink       `json:"linked_tasks"`
+	Attachments         []Attachment     `json:"attachments"`
+	Assignees           []Assignee       `json:"assignees"`
+}
+
+// GetDueDate returns the due date of the task and false if it has none.
+func (t Task) GetDueDate() (time.Time, bool) {
+	return parseTimestamp(t.Duedate)
+}
+
+// GetStartDate returns the start date of the task and false if it has none.
+func (t Task) GetStartDate() (time.Time, bool) {
+	return parseTimestamp(t.Startdate)
+}
+
+// GetTimeEstimate returns the time estimate of the task and false if it has
+// none.
+func (t Task) GetTimeEstimate() (time.Duration, bool) {
+	ms, ok := parseMillis(t.Timeestimate)
+	return time.Duration(ms) * time.Millisecond, ok
+}
+
+// parseTimestamp parses unix timestamps in milliseconds which are returned by
+// the API either as strings or numbers.
+func parseTimestamp(v interface{}) (time.Time, bool) {
+	ms, ok := parseMillis(v)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	return time.UnixMilli(ms), true
+}
+
+func parseMillis(v interface{}) (int64, bool) {
+	switch v := v.(type) {
+	case string:
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, false
+		}
+		return i, true
+	case float64:
+		return int64(v), true
+	case int64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+// Priorities are names of task priorities ordered by their levels starting
+// from 1.
+var Priorities = []string{"urgent", "high", "normal", "low"}
+
+type TaskPriority struct {
+	Id         string `json:"id"`
+	Priority   string `json:"priority"`
+	Color      string `json:"color"`
+	Orderindex string `json:"orderindex"`
+}
+
+// UnmarshalJSON accepts null returned for tasks without a priority.
+func (p *TaskPriority) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" || string(data) == `""` {
+		*p = TaskPriority{}
+		return nil
+	}
+
+	type priority TaskPriority
+	return json.Unmarshal(data, (*priority)(p))
+}
+
+// Level returns the priority level from 1 (urgent) to 4 (low) or 0 if the
+// task has no priority.
+func (p TaskPriority) Level() int32 {
+	level, err := strconv.ParseInt(p.Id, 10, 32)
+	if err != nil {
+		return 0
+	}
+
+	return int32(level)
+}
+
+// ParsePriority returns the priority of the given name or level. An empty
+// value or "none" returns no priority.
+func ParsePriority(s string) (TaskPriority, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" || s == "none" {
+		return TaskPriority{}, true
+	}
+
+	for i, name := range Priorities {
+		level := strconv.Itoa(i + 1)
+		if s == name || s == level {
+			return TaskPriority{
+				Id:         level,
+				Priority:   name,
+				Orderindex: level,
+			}, true
+		}
+	}
+
+	return TaskPriority{}, false
 }
 
 type TaskTag struct {
@@ -43,13 +151,14 @@ type TaskTag struct {
 	Created int64  `json:"created"`
 }
 
+// GetTags returns names of the tags separated by commas.
 func (t Task) GetTags() string {
-	tags := strings.Builder{}
-	for _, tag := range t.Tags {
-		tags.WriteString(tag.Name)
+	names := make([]string, len(t.Tags))
+	for i, tag := range t.Tags {
+		names[i] = tag.Name
 	}
 
-	return tags.String()
+	return strings.Join(names, ", ")
 }
 
 type TaskList struct {
@@ -104,6 +213,10 @@ type Status struct {
 	Orderindex int    `json:"orderindex"`
 }
 
+// StatusTypeClosed is the type of the status which closes tasks. Closed tasks
+// are listed only when asked for.
+const StatusTypeClosed = "closed"
+
 type Creator struct {
 	Username       string `json:"username"`
 	Color          string `json:"color"`
@@ -173,6 +286,43 @@ func (c *Client) GetTasksFromList(listId string) ([]Task, error) {
 	return c.getTasks("/list/" + listId + "/task")
 }
 
+// GetArchivedTasksFromList returns only archived tasks of the list.
+func (c *Client) GetArchivedTasksFromList(listId string) ([]Task, error) {
+	return c.getTasks("/list/"+listId+"/task", "archived", "true")
+}
+
+// GetTasksFromListUpdatedSince returns tasks of the list updated after the
+// given time. Closed tasks are included so callers learn about tasks that
+// were closed in the meantime.
+func (c *Client) GetTasksFromListUpdatedSince(listId string, since time.Time) ([]Task, error) {
+	return c.getTasks("/list/"+listId+"/task",
+		"date_updated_gt", strconv.FormatInt(since.UnixMilli(), 10),
+		"include_closed", "true",
+	)
+}
+
+// GetTasksAssignedTo returns tasks of all lists in the team that are assigned
+// to the user. The endpoint is paginated so all pages are fetched.
+func (c *Client) GetTasksAssignedTo(teamId string, userId string) ([]Task, error) {
+	tasks := []Task{}
+
+	for page := 0; ; page++ {
+		var objmap RequestGetTasks
+		if err := c.get("/team/"+teamId+"/task", &objmap,
+			"assignees[]", userId,
+			"page", strconv.Itoa(page),
+		); err != nil {
+			return nil, err
+		}
+
+		tasks = append(tasks, objmap.Tasks...)
+
+		if objmap.LastPage || len(objmap.Tasks) == 0 {
+			return tasks, nil
+		}
+	}
+}
+
 func (c *Client) GetTask(taskId string) (Task, error) {
 	rawData, err := c.requestGet("/task/"+taskId, "include_markdown_description", "true")
 	if err != nil {
@@ -187,9 +337,9 @@ func (c *Client) GetTask(taskId string) (Task, error) {
 	return objmap, nil
 }
 
-func (c *Client) getTasks(url string) ([]Task, error) {
+func (c *Client) getTasks(url string, paramsQuery ...string) ([]Task, error) {
 	var objmap RequestGetTasks
-	if err := c.get(url, &objmap); err != nil {
+	if err := c.get(url, &objmap, paramsQuery...); err != nil {
 		return nil, err
 	}
 	return objmap.Tasks, nil
@@ -204,3 +354,43 @@ func (c *Client) UpdateTask(r RequestPutTask) (Task, error) {
 
 	return objmap, nil
 }
+
+// SetTaskArchived archives or unarchives the task.
+func (c *Client) SetTaskArchived(taskId string, archived bool) (Task, error) {
+	var objmap Task
+
+	if err := c.update("/task/"+taskId, RequestArchive{Archived: archived}, &objmap); err != nil {
+		return objmap, err
+	}
+
+	return objmap, nil
+}
+
+// AddTaskTag adds the tag of the space to the task.
+func (c *Client) AddTaskTag(taskId string, tag string) error {
+	return c.create("/task/"+taskId+"/tag/"+url.PathEscape(tag), struct{}{}, nil)
+}
+
+// RemoveTaskTag removes the tag from the task without deleting it from the
+// space.
+func (c *Client) RemoveTaskTag(taskId string, tag string) error {
+	return c.delete("/task/" + taskId + "/tag/" + url.PathEscape(tag))
+}
+
+// MoveTask changes the home list of the task to the list of the same
+// workspace.
+func (c *Client) MoveTask(teamId string, taskId string, listId string) error {
+	endpoint := "/workspaces/" + teamId + "/tasks/" + taskId + "/home_list/" + listId
+	errMsg := "Error occurs while moving task at url: %s. Error: %s. Raw data: %s"
+
+	rawData, err := c.requestV3("PUT", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf(errMsg, endpoint, err, "none")
+	}
+
+	if err := checkResponseError(rawData); err != nil {
+		return fmt.Errorf(errMsg, endpoint, err, string(rawData))
+	}
+
+	return nil
+}
@@ -3,11 +3,15 @@ package clickup
 type Workspace = Team
 
 type Team struct {
-	Id      string        `json:"id"`
-	Name    string        `json:"name"`
-	Color   string        `json:"color"`
-	Avatar  string        `json:"avatar"`
-	Members []interface{} `json:"members"`
+	Id      string       `json:"id"`
+	Name    string       `json:"name"`
+	Color   string       `json:"color"`
+	Avatar  string       `json:"avatar"`
+	Members []TeamMember `json:"members"`
+}
+
+type TeamMember struct {
+	User User `json:"user"`
 }
 
 type RequestGetTeams struct {
@@ -0,0 +1,28 @@
+package clickup
+
+type User struct {
+	Username       string `json:"username"`
+	Email          string `json:"email"`
+	Color          string `json:"color"`
+	Initials       string `json:"initials"`
+	ProfilePicture string `json:"profilePicture"`
+	Id             int    `json:"id"`
+}
+
+type RequestGetUser struct {
+	User User   `json:"user"`
+	Err  string `json:"err"`
+}
+
+func (r RequestGetUser) Error() string {
+	return r.Err
+}
+
+func (c *Client) GetAuthorizedUser() (User, error) {
+	var objmap RequestGetUser
+	if err := c.get("/user", &objmap); err != nil {
+		return User{}, err
+	}
+
+	return objmap.User, nil
+}
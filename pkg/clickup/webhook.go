@@ -0,0 +1,102 @@
+package clickup
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+const (
+	WebhookEventTaskCreated = "taskCreated"
+	WebhookEventTaskUpdated = "taskUpdated"
+	WebhookEventTaskDeleted = "taskDeleted"
+
+	// WebhookStatusActive is the health of a webhook which events are
+	// delivered. Failing webhooks are suspended by ClickUp.
+	WebhookStatusActive = "active"
+
+	// WebhookSignatureHeader holds the HMAC SHA-256 of the payload signed
+	// with the secret of the webhook.
+	WebhookSignatureHeader = "X-Signature"
+)
+
+type Webhook struct {
+	Id       string        `json:"id"`
+	Endpoint string        `json:"endpoint"`
+	Secret   string        `json:"secret"`
+	Events   []string      `json:"events"`
+	UserId   int           `json:"userid"`
+	TeamId   int           `json:"team_id"`
+	Health   WebhookHealth `json:"health"`
+}
+
+type WebhookHealth struct {
+	Status    string `json:"status"`
+	FailCount int    `json:"fail_count"`
+}
+
+type RequestGetWebhooks struct {
+	Webhooks []Webhook `json:"webhooks"`
+	Err      string    `json:"err"`
+}
+
+func (r RequestGetWebhooks) Error() string {
+	return r.Err
+}
+
+type RequestCreateWebhook struct {
+	Endpoint string   `json:"endpoint"`
+	Events   []string `json:"events"`
+}
+
+type ResponseCreateWebhook struct {
+	Id      string  `json:"id"`
+	Webhook Webhook `json:"webhook"`
+}
+
+// WebhookEvent is the payload sent by ClickUp to the webhook endpoint.
+type WebhookEvent struct {
+	Event        string        `json:"event"`
+	TaskId       string        `json:"task_id"`
+	WebhookId    string        `json:"webhook_id"`
+	HistoryItems []interface{} `json:"history_items"`
+}
+
+// GetWebhooks returns webhooks of the team created by the user of the token.
+func (c *Client) GetWebhooks(teamId string) ([]Webhook, error) {
+	var objmap RequestGetWebhooks
+	if err := c.get("/team/"+teamId+"/webhook", &objmap); err != nil {
+		return nil, err
+	}
+
+	return objmap.Webhooks, nil
+}
+
+func (c *Client) CreateWebhook(teamId string, r RequestCreateWebhook) (Webhook, error) {
+	var objmap ResponseCreateWebhook
+
+	if err := c.create("/team/"+teamId+"/webhook", r, &objmap); err != nil {
+		return Webhook{}, err
+	}
+
+	return objmap.Webhook, nil
+}
+
+func (c *Client) DeleteWebhook(webhookId string) error {
+	return c.delete("/webhook/" + webhookId)
+}
+
+// VerifyWebhookSignature reports whether the signature matches the body
+// signed with the secret of the webhook. Nothing is verified without the
+// secret.
+func VerifyWebhookSignature(secret string, body []byte, signature string) bool {
+	if secret == "" {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	expected := hex.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
@@ -0,0 +1,130 @@
+// Package merge merges text edited concurrently from the same base version
+// line by line, the same way as diff3.
+package merge
+
+import (
+	"slices"
+	"strings"
+)
+
+const (
+	MarkerLocal  = "<<<<<<< yours"
+	MarkerBase   = "||||||| original"
+	MarkerSep    = "======="
+	MarkerRemote = ">>>>>>> theirs"
+)
+
+// Merge combines changes made to base in local and remote. Lines changed
+// differently on both sides are kept between conflict markers and false is
+// returned.
+func Merge(base string, local string, remote string) (string, bool) {
+	switch {
+	case local == remote, remote == base:
+		return local, true
+	case local == base:
+		return remote, true
+	}
+
+	var (
+		baseLines   = splitLines(base)
+		localLines  = splitLines(local)
+		remoteLines = splitLines(remote)
+		toLocal     = match(baseLines, localLines)
+		toRemote    = match(baseLines, remoteLines)
+	)
+
+	result := []string{}
+	clean := true
+
+	// flush resolves the chunk between lines stable on all sides
+	flush := func(b, l, r []string) {
+		switch {
+		case slices.Equal(l, r), slices.Equal(r, b):
+			result = append(result, l...)
+		case slices.Equal(l, b):
+			result = append(result, r...)
+		default:
+			clean = false
+			result = append(result, MarkerLocal)
+			result = append(result, l...)
+			result = append(result, MarkerBase)
+			result = append(result, b...)
+			result = append(result, MarkerSep)
+			result = append(result, r...)
+			result = append(result, MarkerRemote)
+		}
+	}
+
+	i, l, r := 0, 0, 0
+	for j := range baseLines {
+		if toLocal[j] < 0 || toRemote[j] < 0 {
+			continue
+		}
+
+		flush(baseLines[i:j], localLines[l:toLocal[j]], remoteLines[r:toRemote[j]])
+		result = append(result, baseLines[j])
+
+		i, l, r = j+1, toLocal[j]+1, toRemote[j]+1
+	}
+	flush(baseLines[i:], localLines[l:], remoteLines[r:])
+
+	return strings.Join(result, "\n"), clean
+}
+
+// HasConflicts reports whether the text still contains conflict markers.
+func HasConflicts(s string) bool {
+	for _, line := range splitLines(s) {
+		if line == MarkerLocal || line == MarkerRemote {
+			return true
+		}
+	}
+
+	return false
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(s, "\n")
+}
+
+// match returns for each line of a the index of the same line in b taken
+// from their longest common subsequence or -1 if the line is not in it.
+func match(a []string, b []string) []int {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	result := make([]int, len(a))
+	for i := range result {
+		result[i] = -1
+	}
+
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] == b[j]:
+			result[i] = j
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,162 @@
+package merge
+
+import (
+	"strings"
+	"testing"
+)
+
+func lines(s ...string) string {
+	return strings.Join(s, "\n")
+}
+
+func TestMerge(t *testing.T) {
+	base := lines("one", "two", "three", "four", "five")
+
+	tests := []struct {
+		name      string
+		base      string
+		local     string
+		remote    string
+		want      string
+		wantClean bool
+	}{
+		{
+			name:      "nothing changed",
+			base:      base,
+			local:     base,
+			remote:    base,
+			want:      base,
+			wantClean: true,
+		},
+		{
+			name:      "changed locally",
+			base:      base,
+			local:     lines("one", "TWO", "three", "four", "five"),
+			remote:    base,
+			want:      lines("one", "TWO", "three", "four", "five"),
+			wantClean: true,
+		},
+		{
+			name:      "changed remotely",
+			base:      base,
+			local:     base,
+			remote:    lines("one", "two", "three", "FOUR", "five"),
+			want:      lines("one", "two", "three", "FOUR", "five"),
+			wantClean: true,
+		},
+		{
+			name:      "same change on both sides",
+			base:      base,
+			local:     lines("one", "TWO", "three", "four", "five"),
+			remote:    lines("one", "TWO", "three", "four", "five"),
+			want:      lines("one", "TWO", "three", "four", "five"),
+			wantClean: true,
+		},
+		{
+			name:      "different lines changed",
+			base:      base,
+			local:     lines("one", "TWO", "three", "four", "five"),
+			remote:    lines("one", "two", "three", "FOUR", "five"),
+			want:      lines("one", "TWO", "three", "FOUR", "five"),
+			wantClean: true,
+		},
+		{
+			name:      "lines added and removed",
+			base:      base,
+			local:     lines("zero", "one", "two", "three", "four", "five"),
+			remote:    lines("one", "two", "four", "five", "six"),
+			want:      lines("zero", "one", "two", "four", "five", "six"),
+			wantClean: true,
+		},
+		{
+			name:      "added to empty base",
+			base:      "",
+			local:     "local",
+			remote:    "",
+			want:      "local",
+			wantClean: true,
+		},
+		{
+			name:   "same line changed differently",
+			base:   base,
+			local:  lines("one", "two", "local", "four", "five"),
+			remote: lines("one", "two", "remote", "four", "five"),
+			want: lines(
+				"one", "two",
+				MarkerLocal, "local",
+				MarkerBase, "three",
+				MarkerSep, "remote",
+				MarkerRemote,
+				"four", "five",
+			),
+			wantClean: false,
+		},
+		{
+			name:   "conflict next to a clean change",
+			base:   base,
+			local:  lines("ONE", "two", "local", "four", "five"),
+			remote: lines("one", "two", "remote", "four", "FIVE"),
+			want: lines(
+				"ONE", "two",
+				MarkerLocal, "local",
+				MarkerBase, "three",
+				MarkerSep, "remote",
+				MarkerRemote,
+				"four", "FIVE",
+			),
+			wantClean: false,
+		},
+		{
+			name:   "added differently to empty base",
+			base:   "",
+			local:  "local",
+			remote: "remote",
+			want: lines(
+				MarkerLocal, "local",
+				MarkerBase,
+				MarkerSep, "remote",
+				MarkerRemote,
+			),
+			wantClean: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, clean := Merge(tt.base, tt.local, tt.remote)
+			if got != tt.want {
+				t.Errorf("Merge() =\n%s\nwant\n%s", got, tt.want)
+			}
+			if clean != tt.wantClean {
+				t.Errorf("Merge() clean = %v, want %v", clean, tt.wantClean)
+			}
+			if HasConflicts(got) == clean {
+				t.Errorf("HasConflicts() = %v for a merge with clean = %v", HasConflicts(got), clean)
+			}
+		})
+	}
+}
+
+func TestHasConflicts(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{"empty", "", false},
+		{"plain text", lines("one", "two"), false},
+		{"local marker", lines("one", MarkerLocal, "two"), true},
+		{"remote marker", lines("one", MarkerRemote), true},
+		{"markers resolved", lines("one", "local", "two"), false},
+		{"marker inside a line", "see " + MarkerLocal, false},
+		{"separator only", lines("one", MarkerSep, "two"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasConflicts(tt.s); got != tt.want {
+				t.Errorf("HasConflicts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,494 @@
+// Package nldate parses dates and durations written the way people type
+// them, e.g. "tomorrow 5pm", "next fri", "+3d" or "2h30m".
+package nldate
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrEmpty       = errors.New("empty input")
+	ErrInvalid     = errors.New("cannot parse")
+	ErrNegative    = errors.New("negative duration")
+	ErrConflicting = errors.New("conflicting date parts")
+)
+
+var (
+	clockRe  = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(am|pm)?$`)
+	offsetRe = regexp.MustCompile(`^([+-])(\d+)([a-z]+)$`)
+	amountRe = regexp.MustCompile(`^(\d+)([a-z]*)$`)
+	dayRe    = regexp.MustCompile(`^(\d{1,2})(?:st|nd|rd|th)?$`)
+	yearRe   = regexp.MustCompile(`^\d{4}$`)
+)
+
+var dateLayouts = []string{"2006-01-02", "2006/01/02", "02.01.2006"}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tues": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+var months = map[string]time.Month{
+	"jan": time.January, "january": time.January,
+	"feb": time.February, "february": time.February,
+	"mar": time.March, "march": time.March,
+	"apr": time.April, "april": time.April,
+	"may": time.May,
+	"jun": time.June, "june": time.June,
+	"jul": time.July, "july": time.July,
+	"aug": time.August, "august": time.August,
+	"sep": time.September, "sept": time.September, "september": time.September,
+	"oct": time.October, "october": time.October,
+	"nov": time.November, "november": time.November,
+	"dec": time.December, "december": time.December,
+}
+
+// unit is a calendar unit of relative dates.
+type unit int
+
+const (
+	unitMinute unit = iota
+	unitHour
+	unitDay
+	unitWeek
+	unitMonth
+	unitYear
+)
+
+var units = map[string]unit{
+	"m": unitMinute, "min": unitMinute, "mins": unitMinute, "minute": unitMinute, "minutes": unitMinute,
+	"h": unitHour, "hr": unitHour, "hrs": unitHour, "hour": unitHour, "hours": unitHour,
+	"d": unitDay, "day": unitDay, "days": unitDay,
+	"w": unitWeek, "wk": unitWeek, "wks": unitWeek, "week": unitWeek, "weeks": unitWeek,
+	"mo": unitMonth, "month": unitMonth, "months": unitMonth,
+	"y": unitYear, "yr": unitYear, "yrs": unitYear, "year": unitYear, "years": unitYear,
+}
+
+// parser collects parts of a date. The day and the time of day are set
+// independently, exact is set by parts naming a moment, e.g. "now" or "+2h".
+type parser struct {
+	now    time.Time
+	tokens []string
+	pos    int
+
+	day      time.Time
+	daySet   bool
+	hour     int
+	minute   int
+	clockSet bool
+	exact    time.Time
+	exactSet bool
+}
+
+// Parse returns the date described by s relative to now in the location of
+// now. Dates without a time of day are at midnight and false is returned
+// for them.
+//
+// Supported parts, which can be combined, e.g. "next fri at 9am":
+//
+//	today, tomorrow, yesterday, now, noon, midnight
+//	mon ... sunday, next fri, last fri, this fri
+//	next week, next month, next year
+//	+3d, -1w, +2h, in 3 days, 2 weeks ago
+//	2024-05-01, may 1, 1st may 2025
+//	5pm, 5:30pm, 17:00, 5 pm
+func Parse(s string, now time.Time) (time.Time, bool, error) {
+	tokens := strings.Fields(strings.ToLower(strings.ReplaceAll(s, ",", " ")))
+	if len(tokens) == 0 {
+		return time.Time{}, false, ErrEmpty
+	}
+
+	p := &parser{
+		now:    now,
+		tokens: tokens,
+		day:    startOfDay(now),
+	}
+
+	for p.pos < len(p.tokens) {
+		if err := p.parseToken(); err != nil {
+			return time.Time{}, false, err
+		}
+	}
+
+	switch {
+	case p.exactSet && (p.daySet || p.clockSet):
+		return time.Time{}, false, fmt.Errorf("%w: %q", ErrConflicting, s)
+	case p.exactSet:
+		return p.exact, true, nil
+	case p.clockSet:
+		return p.day.Add(time.Duration(p.hour)*time.Hour + time.Duration(p.minute)*time.Minute), true, nil
+	default:
+		return p.day, false, nil
+	}
+}
+
+func (p *parser) peek(offset int) string {
+	if p.pos+offset >= len(p.tokens) {
+		return ""
+	}
+
+	return p.tokens[p.pos+offset]
+}
+
+func (p *parser) parseToken() error {
+	tok := p.peek(0)
+
+	switch tok {
+	case "at", "on":
+		p.pos++
+		return nil
+	case "now":
+		p.pos++
+		return p.setExact(p.now)
+	case "today", "tonight":
+		p.pos++
+		return p.setDay(startOfDay(p.now))
+	case "tomorrow", "tmr", "tmrw":
+		p.pos++
+		return p.setDay(startOfDay(p.now).AddDate(0, 0, 1))
+	case "yesterday":
+		p.pos++
+		return p.setDay(startOfDay(p.now).AddDate(0, 0, -1))
+	case "noon":
+		p.pos++
+		return p.setClock(12, 0)
+	case "midnight":
+		p.pos++
+		return p.setClock(0, 0)
+	case "next", "last", "this":
+		return p.parseRelative()
+	case "in":
+		return p.parseIn()
+	}
+
+	if wd, ok := weekdays[tok]; ok {
+		p.pos++
+		return p.setDay(p.weekday(wd, 0))
+	}
+
+	if _, ok := months[tok]; ok {
+		return p.parseMonthDay()
+	}
+
+	if m := offsetRe.FindStringSubmatch(tok); m != nil {
+		n, _ := strconv.Atoi(m[2])
+		u, ok := units[m[3]]
+		if !ok {
+			return fmt.Errorf("%w: unknown unit %q", ErrInvalid, m[3])
+		}
+		if m[1] == "-" {
+			n = -n
+		}
+
+		p.pos++
+		return p.addOffset(n, u)
+	}
+
+	for _, layout := range dateLayouts {
+		if t, err := time.ParseInLocation(layout, tok, p.now.Location()); err == nil {
+			p.pos++
+			return p.setDay(t)
+		}
+	}
+
+	if dayRe.MatchString(tok) {
+		if _, ok := months[p.peek(1)]; ok {
+			return p.parseMonthDay()
+		}
+	}
+
+	if amountRe.MatchString(tok) {
+		if ok, err := p.parseAgo(); ok || err != nil {
+			return err
+		}
+	}
+
+	return p.parseClock()
+}
+
+// parseRelative parses "next", "last" or "this" followed by a weekday or a
+// calendar unit.
+func (p *parser) parseRelative() error {
+	word, target := p.peek(0), p.peek(1)
+	p.pos += 2
+
+	direction := map[string]int{"next": 1, "last": -1, "this": 0}[word]
+
+	if wd, ok := weekdays[target]; ok {
+		return p.setDay(p.weekday(wd, direction))
+	}
+
+	u, ok := units[target]
+	if !ok || u < unitDay {
+		return fmt.Errorf("%w: %q", ErrInvalid, word+" "+target)
+	}
+
+	return p.addOffset(direction, u)
+}
+
+// parseIn parses "in 3 days" or "in 3d".
+func (p *parser) parseIn() error {
+	p.pos++
+
+	n, u, ok := p.amount()
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrInvalid, "in "+p.peek(0))
+	}
+
+	return p.addOffset(n, u)
+}
+
+// parseAgo parses "2 days ago" and reports whether the tokens matched.
+func (p *parser) parseAgo() (bool, error) {
+	start := p.pos
+
+	n, u, ok := p.amount()
+	if !ok || p.peek(0) != "ago" {
+		p.pos = start
+		return false, nil
+	}
+	p.pos++
+
+	return true, p.addOffset(-n, u)
+}
+
+// amount parses a number with a unit given in the same or the next token.
+func (p *parser) amount() (int, unit, bool) {
+	m := amountRe.FindStringSubmatch(p.peek(0))
+	if m == nil {
+		return 0, 0, false
+	}
+
+	n, _ := strconv.Atoi(m[1])
+	name, skip := m[2], 1
+	if name == "" {
+		name, skip = p.peek(1), 2
+	}
+
+	u, ok := units[name]
+	if !ok {
+		return 0, 0, false
+	}
+
+	p.pos += skip
+	return n, u, true
+}
+
+// parseMonthDay parses "may 1" or "1 may" with an optional year. Without the
+// year the next such day is used.
+func (p *parser) parseMonthDay() error {
+	first, second := p.peek(0), p.peek(1)
+
+	month, ok := months[first]
+	dayTok := second
+	if !ok {
+		month = months[second]
+		dayTok = first
+	}
+
+	m := dayRe.FindStringSubmatch(dayTok)
+	if m == nil {
+		return fmt.Errorf("%w: %q", ErrInvalid, first+" "+second)
+	}
+	day, _ := strconv.Atoi(m[1])
+	p.pos += 2
+
+	year := p.now.Year()
+	explicitYear := yearRe.MatchString(p.peek(0))
+	if explicitYear {
+		year, _ = strconv.Atoi(p.peek(0))
+		p.pos++
+	}
+
+	t := time.Date(year, month, day, 0, 0, 0, 0, p.now.Location())
+	if t.Month() != month {
+		return fmt.Errorf("%w: no day %d in %s", ErrInvalid, day, month)
+	}
+
+	if !explicitYear && t.Before(startOfDay(p.now)) {
+		t = t.AddDate(1, 0, 0)
+	}
+
+	return p.setDay(t)
+}
+
+// parseClock parses "5pm", "5:30pm", "17:00" or "5 pm".
+func (p *parser) parseClock() error {
+	tok := p.peek(0)
+	if next := p.peek(1); (next == "am" || next == "pm") && !strings.HasSuffix(tok, "m") {
+		tok += next
+		p.pos++
+	}
+
+	m := clockRe.FindStringSubmatch(tok)
+	if m == nil || (m[2] == "" && m[3] == "") {
+		return fmt.Errorf("%w: %q", ErrInvalid, p.peek(0))
+	}
+	p.pos++
+
+	hour, _ := strconv.Atoi(m[1])
+	minute, _ := strconv.Atoi(m[2])
+
+	switch m[3] {
+	case "am", "pm":
+		if hour < 1 || hour > 12 {
+			return fmt.Errorf("%w: hour %q", ErrInvalid, tok)
+		}
+		hour %= 12
+		if m[3] == "pm" {
+			hour += 12
+		}
+	default:
+		if hour > 23 {
+			return fmt.Errorf("%w: hour %q", ErrInvalid, tok)
+		}
+	}
+
+	if minute > 59 {
+		return fmt.Errorf("%w: minute %q", ErrInvalid, tok)
+	}
+
+	return p.setClock(hour, minute)
+}
+
+// weekday returns the day of the week relative to today. With direction 0 it
+// is today or the following one, 1 the following one after today and -1 the
+// last one before today.
+func (p *parser) weekday(wd time.Weekday, direction int) time.Time {
+	today := startOfDay(p.now)
+	diff := int(wd - today.Weekday())
+
+	switch direction {
+	case 1:
+		if diff <= 0 {
+			diff += 7
+		}
+	case -1:
+		if diff >= 0 {
+			diff -= 7
+		}
+	default:
+		if diff < 0 {
+			diff += 7
+		}
+	}
+
+	return today.AddDate(0, 0, diff)
+}
+
+// addOffset moves the day by calendar units or the moment by hours and
+// minutes.
+func (p *parser) addOffset(n int, u unit) error {
+	switch u {
+	case unitMinute:
+		return p.setExact(p.now.Add(time.Duration(n) * time.Minute))
+	case unitHour:
+		return p.setExact(p.now.Add(time.Duration(n) * time.Hour))
+	case unitDay:
+		p.day = p.day.AddDate(0, 0, n)
+	case unitWeek:
+		p.day = p.day.AddDate(0, 0, 7*n)
+	case unitMonth:
+		p.day = addMonths(p.day, n)
+	case unitYear:
+		p.day = addMonths(p.day, 12*n)
+	}
+
+	p.daySet = true
+	return nil
+}
+
+func (p *parser) setDay(t time.Time) error {
+	if p.daySet {
+		return ErrConflicting
+	}
+
+	p.day, p.daySet = t, true
+	return nil
+}
+
+func (p *parser) setClock(hour int, minute int) error {
+	if p.clockSet {
+		return ErrConflicting
+	}
+
+	p.hour, p.minute, p.clockSet = hour, minute, true
+	return nil
+}
+
+func (p *parser) setExact(t time.Time) error {
+	if p.exactSet {
+		return ErrConflicting
+	}
+
+	p.exact, p.exactSet = t, true
+	return nil
+}
+
+// addMonths moves the day by months keeping it in the target month, e.g. a
+// month after January 31 is the last day of February, not March 2.
+func addMonths(t time.Time, n int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(n), 1, 0, 0, 0, 0, t.Location())
+	last := first.AddDate(0, 1, -1).Day()
+
+	return first.AddDate(0, 0, min(t.Day(), last)-1)
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+var durationUnits = strings.NewReplacer(
+	"hours", "h", "hour", "h", "hrs", "h", "hr", "h",
+	"minutes", "m", "minute", "m", "mins", "m", "min", "m",
+)
+
+// ParseDuration parses durations like "2h30m", "2h 30m", "1.5h" or "90 min".
+// A plain number is a number of minutes.
+func ParseDuration(s string) (time.Duration, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return 0, ErrEmpty
+	}
+
+	s = strings.ReplaceAll(durationUnits.Replace(s), " ", "")
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		s += "m"
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: duration %q", ErrInvalid, s)
+	}
+
+	if d < 0 {
+		return 0, ErrNegative
+	}
+
+	return d, nil
+}
+
+// FormatDuration formats the duration in hours and minutes, e.g. "2h30m".
+func FormatDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	hours, minutes := int(d.Hours()), int(d.Minutes())%60
+
+	switch {
+	case hours == 0:
+		return fmt.Sprintf("%dm", minutes)
+	case minutes == 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dh%dm", hours, minutes)
+	}
+}
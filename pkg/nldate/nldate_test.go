@@ -0,0 +1,287 @@
+package nldate
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// now is Wednesday, January 31 2024, the last day of a month before a leap
+// day.
+var now = time.Date(2024, time.January, 31, 14, 30, 0, 0, time.UTC)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func at(year int, month time.Month, day int, hour int, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+}
+
+type parseTest struct {
+	input    string
+	want     time.Time
+	wantTime bool
+}
+
+func runParseTests(t *testing.T, now time.Time, tests []parseTest) {
+	t.Helper()
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, hasTime, err := Parse(tt.input, now)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Parse(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+			if hasTime != tt.wantTime {
+				t.Errorf("Parse(%q) has time = %v, want %v", tt.input, hasTime, tt.wantTime)
+			}
+		})
+	}
+}
+
+func TestParseRelativeDays(t *testing.T) {
+	runParseTests(t, now, []parseTest{
+		{"today", date(2024, time.January, 31), false},
+		{"Tomorrow", date(2024, time.February, 1), false},
+		{"tmrw", date(2024, time.February, 1), false},
+		{"yesterday", date(2024, time.January, 30), false},
+		{"now", now, true},
+		{"noon", at(2024, time.January, 31, 12, 0), true},
+		{"midnight", at(2024, time.January, 31, 0, 0), true},
+		{"+3d", date(2024, time.February, 3), false},
+		{"-1w", date(2024, time.January, 24), false},
+		{"2 days ago", date(2024, time.January, 29), false},
+		{"1w ago", date(2024, time.January, 24), false},
+	})
+}
+
+func TestParseWeekdays(t *testing.T) {
+	runParseTests(t, now, []parseTest{
+		{"wed", date(2024, time.January, 31), false},
+		{"Friday", date(2024, time.February, 2), false},
+		{"mon", date(2024, time.February, 5), false},
+		{"this wed", date(2024, time.January, 31), false},
+		{"this tue", date(2024, time.February, 6), false},
+		{"next wed", date(2024, time.February, 7), false},
+		{"next fri", date(2024, time.February, 2), false},
+		{"last wed", date(2024, time.January, 24), false},
+		{"last fri", date(2024, time.January, 26), false},
+	})
+}
+
+func TestParseNextAndIn(t *testing.T) {
+	runParseTests(t, now, []parseTest{
+		{"next week", date(2024, time.February, 7), false},
+		{"next year", date(2025, time.January, 31), false},
+		{"last week", date(2024, time.January, 24), false},
+		{"in 3 days", date(2024, time.February, 3), false},
+		{"in 3d", date(2024, time.February, 3), false},
+		{"in 2 weeks", date(2024, time.February, 14), false},
+		{"in 2h", at(2024, time.January, 31, 16, 30), true},
+		{"in 45 min", at(2024, time.January, 31, 15, 15), true},
+		{"+2h", at(2024, time.January, 31, 16, 30), true},
+		{"-30m", at(2024, time.January, 31, 14, 0), true},
+		{"in 12 hours", at(2024, time.February, 1, 2, 30), true},
+	})
+}
+
+func TestParseTimes(t *testing.T) {
+	runParseTests(t, now, []parseTest{
+		{"5pm", at(2024, time.January, 31, 17, 0), true},
+		{"5:30pm", at(2024, time.January, 31, 17, 30), true},
+		{"5 pm", at(2024, time.January, 31, 17, 0), true},
+		{"17:00", at(2024, time.January, 31, 17, 0), true},
+		{"09:05", at(2024, time.January, 31, 9, 5), true},
+		{"12am", at(2024, time.January, 31, 0, 0), true},
+		{"12pm", at(2024, time.January, 31, 12, 0), true},
+		{"tomorrow 5pm", at(2024, time.February, 1, 17, 0), true},
+		{"tomorrow, 9:15", at(2024, time.February, 1, 9, 15), true},
+		{"next fri at 9am", at(2024, time.February, 2, 9, 0), true},
+		{"in 2 days noon", at(2024, time.February, 2, 12, 0), true},
+	})
+}
+
+func TestParseDates(t *testing.T) {
+	runParseTests(t, now, []parseTest{
+		{"2024-05-01", date(2024, time.May, 1), false},
+		{"2024/05/01", date(2024, time.May, 1), false},
+		{"01.05.2024", date(2024, time.May, 1), false},
+		{"may 1", date(2024, time.May, 1), false},
+		{"1st may 2025", date(2025, time.May, 1), false},
+		{"jan 31", date(2024, time.January, 31), false},
+		{"jan 15", date(2025, time.January, 15), false},
+		{"jan 15 2024", date(2024, time.January, 15), false},
+		{"on may 1 at 10:00", at(2024, time.May, 1, 10, 0), true},
+	})
+}
+
+func TestParseMonthEnd(t *testing.T) {
+	runParseTests(t, now, []parseTest{
+		{"next month", date(2024, time.February, 29), false},
+		{"+1mo", date(2024, time.February, 29), false},
+		{"in 1 month", date(2024, time.February, 29), false},
+		{"+2mo", date(2024, time.March, 31), false},
+		{"+3mo", date(2024, time.April, 30), false},
+		{"last month", date(2023, time.December, 31), false},
+		{"feb 29", date(2024, time.February, 29), false},
+	})
+
+	leapDay := time.Date(2024, time.February, 29, 8, 0, 0, 0, time.UTC)
+	runParseTests(t, leapDay, []parseTest{
+		{"tomorrow", date(2024, time.March, 1), false},
+		{"next year", date(2025, time.February, 28), false},
+		{"+4y", date(2028, time.February, 29), false},
+		{"next month", date(2024, time.March, 29), false},
+	})
+}
+
+func TestParseYearEnd(t *testing.T) {
+	// Tuesday, December 31 2024.
+	newYearsEve := time.Date(2024, time.December, 31, 23, 0, 0, 0, time.UTC)
+
+	runParseTests(t, newYearsEve, []parseTest{
+		{"tomorrow", date(2025, time.January, 1), false},
+		{"wed", date(2025, time.January, 1), false},
+		{"next tue", date(2025, time.January, 7), false},
+		{"next week", date(2025, time.January, 7), false},
+		{"next month", date(2025, time.January, 31), false},
+		{"+2mo", date(2025, time.February, 28), false},
+		{"jan 1", date(2025, time.January, 1), false},
+		{"dec 31", date(2024, time.December, 31), false},
+		{"dec 30", date(2025, time.December, 30), false},
+		{"+2h", at(2025, time.January, 1, 1, 0), true},
+	})
+}
+
+func TestParseLocation(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+
+	got, _, err := Parse("tomorrow 9am", now.In(loc))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := time.Date(2024, time.February, 1, 9, 0, 0, 0, loc); !got.Equal(want) || got.Location() != loc {
+		t.Errorf("Parse() = %v, want %v", got, want)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		input string
+		want  error
+	}{
+		{"", ErrEmpty},
+		{"   ", ErrEmpty},
+		{"someday", ErrInvalid},
+		{"5", ErrInvalid},
+		{"+3x", ErrInvalid},
+		{"in soon", ErrInvalid},
+		{"next hour", ErrInvalid},
+		{"next", ErrInvalid},
+		{"25:00", ErrInvalid},
+		{"13pm", ErrInvalid},
+		{"0am", ErrInvalid},
+		{"5:60", ErrInvalid},
+		{"feb 30", ErrInvalid},
+		{"feb 29 2025", ErrInvalid},
+		{"32 may", ErrInvalid},
+		{"tomorrow yesterday", ErrConflicting},
+		{"5pm 6pm", ErrConflicting},
+		{"now 5pm", ErrConflicting},
+		{"+2h tomorrow", ErrConflicting},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, _, err := Parse(tt.input, now)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Parse(%q) = %v, %v, want error %v", tt.input, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		input string
+		want  time.Duration
+	}{
+		{"2h30m", 2*time.Hour + 30*time.Minute},
+		{"2h 30m", 2*time.Hour + 30*time.Minute},
+		{"1.5h", 90 * time.Minute},
+		{"90 min", 90 * time.Minute},
+		{"90", 90 * time.Minute},
+		{"2 hours", 2 * time.Hour},
+		{"1 hour 15 minutes", 75 * time.Minute},
+		{"45 Mins", 45 * time.Minute},
+		{"0", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseDuration(tt.input)
+			if err != nil {
+				t.Fatalf("ParseDuration(%q) error = %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDuration(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDurationInvalid(t *testing.T) {
+	tests := []struct {
+		input string
+		want  error
+	}{
+		{"", ErrEmpty},
+		{"  ", ErrEmpty},
+		{"soon", ErrInvalid},
+		{"1h30", ErrInvalid},
+		{"2 days", ErrInvalid},
+		{"-1h", ErrNegative},
+		{"-30", ErrNegative},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseDuration(tt.input)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("ParseDuration(%q) = %v, %v, want error %v", tt.input, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "0m"},
+		{29 * time.Second, "0m"},
+		{45 * time.Minute, "45m"},
+		{2 * time.Hour, "2h"},
+		{2*time.Hour + 30*time.Minute, "2h30m"},
+		{89*time.Minute + 40*time.Second, "1h30m"},
+		{26 * time.Hour, "26h"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := FormatDuration(tt.d); got != tt.want {
+				t.Errorf("FormatDuration(%v) = %q, want %q", tt.d, got, tt.want)
+			}
+
+			if d, err := ParseDuration(tt.want); err != nil || d != tt.d.Round(time.Minute) {
+				t.Errorf("ParseDuration(%q) = %v, %v, want %v", tt.want, d, err, tt.d.Round(time.Minute))
+			}
+		})
+	}
+}
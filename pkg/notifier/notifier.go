@@ -0,0 +1,45 @@
+package notifier
+
+import (
+	"os/exec"
+	"strings"
+)
+
+// DefaultCommand is used to send desktop notifications if no command is set.
+const DefaultCommand = "notify-send"
+
+// Notifier sends notifications outside of the application.
+type Notifier interface {
+	Notify(title string, body string) error
+}
+
+// Nop drops all notifications.
+type Nop struct{}
+
+func (Nop) Notify(string, string) error {
+	return nil
+}
+
+// Command sends notifications by executing a command with the title and the
+// body appended as the last arguments, e.g. `notify-send <title> <body>`.
+type Command struct {
+	name string
+	args []string
+}
+
+func NewCommand(command string) *Command {
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		fields = []string{DefaultCommand}
+	}
+
+	return &Command{
+		name: fields[0],
+		args: fields[1:],
+	}
+}
+
+func (c *Command) Notify(title string, body string) error {
+	args := append(append([]string{}, c.args...), title, body)
+	return exec.Command(c.name, args...).Run()
+}
@@ -0,0 +1,8 @@
+//go:build !unix
+
+package termimg
+
+// cellSize is not reported on this platform, cells are approximated.
+func cellSize() (int, int, bool) {
+	return 0, 0, false
+}
@@ -0,0 +1,25 @@
+//go:build unix
+
+package termimg
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// cellSize returns the size of a cell in pixels reported by the terminal of
+// the standard output.
+func cellSize() (int, int, bool) {
+	ws, err := unix.IoctlGetWinsize(int(os.Stdout.Fd()), unix.TIOCGWINSZ)
+	if err != nil || ws.Col == 0 || ws.Row == 0 {
+		return 0, 0, false
+	}
+
+	width, height := int(ws.Xpixel/ws.Col), int(ws.Ypixel/ws.Row)
+	if width == 0 || height == 0 {
+		return 0, 0, false
+	}
+
+	return width, height, true
+}
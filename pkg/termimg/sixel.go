@@ -0,0 +1,126 @@
+package termimg
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/draw"
+	"strings"
+)
+
+// transparent marks pixels left out of sixel graphics.
+const transparent = -1
+
+// encodeSixel returns sixel sequences drawing the image in strips of
+// rowHeight pixels, one per row of cells. Colors are reduced to a palette of
+// 256 with dithering, transparent pixels are not drawn.
+func encodeSixel(img image.Image, rowHeight int) []string {
+	b := img.Bounds()
+
+	paletted := image.NewPaletted(image.Rect(0, 0, b.Dx(), b.Dy()), palette.Plan9)
+	draw.FloydSteinberg.Draw(paletted, paletted.Bounds(), img, b.Min)
+
+	pixels := make([]int, b.Dx()*b.Dy())
+	for y := 0; y < b.Dy(); y++ {
+		for x := 0; x < b.Dx(); x++ {
+			if _, _, _, a := img.At(b.Min.X+x, b.Min.Y+y).RGBA(); a < 0x8000 {
+				pixels[y*b.Dx()+x] = transparent
+				continue
+			}
+
+			pixels[y*b.Dx()+x] = int(paletted.ColorIndexAt(x, y))
+		}
+	}
+
+	sixels := []string{}
+	for y := 0; y < b.Dy(); y += rowHeight {
+		sixels = append(sixels, encodeSixelStrip(pixels, paletted.Palette, b.Dx(), y, min(y+rowHeight, b.Dy())))
+	}
+
+	return sixels
+}
+
+// encodeSixelStrip encodes rows from top to bottom, exclusive, of the
+// palette indexes of pixels.
+func encodeSixelStrip(pixels []int, p color.Palette, width, top, bottom int) string {
+	buf := strings.Builder{}
+
+	// P2=1 keeps pixels without a color transparent, the raster attributes
+	// set the size so the strip is not padded to whole sixels.
+	fmt.Fprintf(&buf, "\x1bP0;1;0q\"1;1;%d;%d", width, bottom-top)
+
+	defined := make([]bool, len(p))
+	for i := top * width; i < bottom*width; i++ {
+		if c := pixels[i]; c != transparent && !defined[c] {
+			defined[c] = true
+
+			r, g, b, _ := p[c].RGBA()
+			fmt.Fprintf(&buf, "#%d;2;%d;%d;%d", c, r*100/0xffff, g*100/0xffff, b*100/0xffff)
+		}
+	}
+
+	for band := top; band < bottom; band += 6 {
+		// bits holds a sixel of every column per color used in the band.
+		bits := map[int][]byte{}
+		colors := []int{}
+
+		for dy := 0; dy < 6 && band+dy < bottom; dy++ {
+			for x := 0; x < width; x++ {
+				c := pixels[(band+dy)*width+x]
+				if c == transparent {
+					continue
+				}
+
+				if _, ok := bits[c]; !ok {
+					bits[c] = make([]byte, width)
+					colors = append(colors, c)
+				}
+				bits[c][x] |= 1 << dy
+			}
+		}
+
+		for i, c := range colors {
+			if i > 0 {
+				// Go back to the start of the band for the next color.
+				buf.WriteByte('$')
+			}
+
+			fmt.Fprintf(&buf, "#%d", c)
+			writeSixels(&buf, bits[c])
+		}
+
+		if band+6 < bottom {
+			buf.WriteByte('-')
+		}
+	}
+
+	buf.WriteString("\x1b\\")
+
+	return buf.String()
+}
+
+// writeSixels writes the columns of a band with repeated sixels compressed.
+// Empty columns at the end are left out.
+func writeSixels(buf *strings.Builder, bits []byte) {
+	end := len(bits)
+	for end > 0 && bits[end-1] == 0 {
+		end--
+	}
+
+	for x := 0; x < end; {
+		n := 1
+		for x+n < end && bits[x+n] == bits[x] {
+			n++
+		}
+
+		ch := '?' + bits[x]
+		if n > 3 {
+			fmt.Fprintf(buf, "!%d%c", n, ch)
+		} else {
+			buf.WriteString(strings.Repeat(string(ch), n))
+		}
+
+		x += n
+	}
+}
@@ -0,0 +1,142 @@
+package termimg
+
+import (
+	"image"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestEncodeSixel(t *testing.T) {
+	// Red on the left and a transparent column on the right, 7 pixels high
+	// to span two bands.
+	img := image.NewRGBA(image.Rect(0, 0, 3, 7))
+	for y := 0; y < 7; y++ {
+		img.Set(0, y, color.RGBA{R: 0xff, A: 0xff})
+		img.Set(1, y, color.RGBA{R: 0xff, A: 0xff})
+	}
+
+	sixels := encodeSixel(img, 20)
+	if len(sixels) != 1 {
+		t.Fatalf("got %d strips, want 1", len(sixels))
+	}
+
+	s := sixels[0]
+	if !strings.HasPrefix(s, "\x1bP0;1;0q\"1;1;3;7#") || !strings.HasSuffix(s, "\x1b\\") {
+		t.Errorf("sixel = %q, want a transparent 3x7 sixel sequence", s)
+	}
+
+	// A full band of two columns, the transparent one is left out, then the
+	// last row.
+	if body := s[strings.LastIndex(s, "#"):]; !strings.Contains(s, "~~-") || !strings.HasSuffix(body, "@@\x1b\\") {
+		t.Errorf("sixel = %q, want bands of two columns", s)
+	}
+	if strings.Count(s, ";2;100;0;0") != 1 {
+		t.Errorf("sixel = %q, want the red color defined once", s)
+	}
+}
+
+func TestEncodeSixelRows(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 45))
+	for y := 0; y < 45; y++ {
+		for x := 0; x < 4; x++ {
+			img.Set(x, y, color.White)
+		}
+	}
+
+	sixels := encodeSixel(img, 20)
+	if len(sixels) != 3 {
+		t.Fatalf("got %d strips, want 3", len(sixels))
+	}
+
+	for i, want := range []string{"\"1;1;4;20", "\"1;1;4;20", "\"1;1;4;5"} {
+		if !strings.Contains(sixels[i], want) {
+			t.Errorf("strip %d = %q, want raster %s", i, sixels[i], want)
+		}
+	}
+}
+
+func TestWriteSixels(t *testing.T) {
+	tests := []struct {
+		name string
+		bits []byte
+		want string
+	}{
+		{"empty", []byte{0, 0}, ""},
+		{"short runs", []byte{1, 1, 2, 0}, "@@A"},
+		{"long run", []byte{63, 63, 63, 63, 63}, "!5~"},
+		{"gap", []byte{1, 0, 0, 0, 0, 1}, "@!4?@"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := strings.Builder{}
+			writeSixels(&buf, tt.bits)
+
+			if got := buf.String(); got != tt.want {
+				t.Errorf("writeSixels() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSixelLines(t *testing.T) {
+	// Two and a half cells wide and one and a half high.
+	width, height := cellPixels()
+	img := image.NewRGBA(image.Rect(0, 0, width*5/2, height*3/2))
+
+	i, err := NewImage(ProtocolSixel, 1, img, 10, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if i.Cols != 3 || i.Rows != 2 {
+		t.Errorf("size = %dx%d cells, want 3x2", i.Cols, i.Rows)
+	}
+
+	lines := i.Lines()
+	if len(lines) != i.Rows {
+		t.Fatalf("got %d lines, want %d", len(lines), i.Rows)
+	}
+	for _, line := range lines {
+		if w := ansi.StringWidth(line); w != i.Cols {
+			t.Errorf("line width = %d, want %d", w, i.Cols)
+		}
+	}
+
+	if err := i.Transmit(&strings.Builder{}); err != nil {
+		t.Errorf("Transmit() error = %v", err)
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name    string
+		setting string
+		env     map[string]string
+		want    Protocol
+	}{
+		{"kitty set", "kitty", nil, ProtocolKitty},
+		{"sixel set", "Sixel", nil, ProtocolSixel},
+		{"none set", "none", map[string]string{"TERM": "xterm-kitty"}, ProtocolNone},
+		{"kitty", "auto", map[string]string{"TERM": "xterm-kitty"}, ProtocolKitty},
+		{"foot", "auto", map[string]string{"TERM": "foot-extra"}, ProtocolSixel},
+		{"wezterm", "auto", map[string]string{"TERM_PROGRAM": "WezTerm"}, ProtocolSixel},
+		{"tmux", "auto", map[string]string{"TERM": "foot", "TMUX": "/tmp/tmux"}, ProtocolNone},
+		{"unknown", "", map[string]string{"TERM": "xterm-256color"}, ProtocolNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{"TERM", "TERM_PROGRAM", "TMUX", "KITTY_WINDOW_ID", "KONSOLE_VERSION"} {
+				t.Setenv(key, tt.env[key])
+			}
+
+			if got := Detect(tt.setting); got != tt.want {
+				t.Errorf("Detect(%q) = %q, want %q", tt.setting, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,267 @@
+// Package termimg shows images inline in terminals supporting the kitty
+// graphics protocol or sixel graphics. Kitty images are drawn with unicode
+// placeholders, i.e. plain text cells, so they can be scrolled and clipped
+// like any other text. Sixel images are drawn a row of cells at a time, so a
+// redrawn line draws its part of the image again.
+package termimg
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+type Protocol string
+
+const (
+	ProtocolAuto  Protocol = "auto"
+	ProtocolKitty Protocol = "kitty"
+	ProtocolSixel Protocol = "sixel"
+	ProtocolNone  Protocol = "none"
+)
+
+const (
+	// cellWidth and cellHeight approximate the size of a terminal cell in
+	// pixels when the terminal does not report it. Images are scaled down to
+	// fit the cells they are shown in.
+	cellWidth  = 10
+	cellHeight = 20
+
+	placeholder = '\U0010EEEE'
+	chunkSize   = 4096
+)
+
+// diacritics encode the row and the column of a placeholder cell. Images are
+// limited to as many rows as there are diacritics.
+var diacritics = []rune{
+	0x0305, 0x030D, 0x030E, 0x0310, 0x0312, 0x033D, 0x033E, 0x033F,
+	0x0346, 0x034A, 0x034B, 0x034C, 0x0350, 0x0351, 0x0352, 0x0357,
+	0x035B, 0x0363, 0x0364, 0x0365, 0x0366, 0x0367, 0x0368, 0x0369,
+	0x036A, 0x036B, 0x036C, 0x036D, 0x036E, 0x036F, 0x0483, 0x0484,
+	0x0485, 0x0486, 0x0487,
+}
+
+// MaxRows is the maximum height of an image in cells.
+var MaxRows = len(diacritics)
+
+// Detect returns the protocol set in the config or, for ProtocolAuto, the one
+// supported by the terminal.
+func Detect(setting string) Protocol {
+	switch p := Protocol(strings.ToLower(setting)); p {
+	case ProtocolKitty, ProtocolSixel, ProtocolNone:
+		return p
+	}
+
+	// Multiplexers do not pass the graphics through.
+	if os.Getenv("TMUX") != "" || strings.HasPrefix(os.Getenv("TERM"), "screen") {
+		return ProtocolNone
+	}
+
+	switch {
+	case os.Getenv("KITTY_WINDOW_ID") != "",
+		os.Getenv("TERM") == "xterm-kitty",
+		os.Getenv("TERM") == "xterm-ghostty",
+		os.Getenv("TERM_PROGRAM") == "ghostty":
+		return ProtocolKitty
+
+	case strings.HasPrefix(os.Getenv("TERM"), "foot"),
+		os.Getenv("TERM") == "mlterm",
+		os.Getenv("TERM_PROGRAM") == "WezTerm",
+		os.Getenv("KONSOLE_VERSION") != "":
+		return ProtocolSixel
+	}
+
+	return ProtocolNone
+}
+
+// Image is an image ready to be sent to the terminal.
+type Image struct {
+	Id       uint32
+	Cols     int
+	Rows     int
+	Protocol Protocol
+	data     []byte   // png, kitty only
+	sixels   []string // a sequence per row, sixel only
+}
+
+// NewImage scales the image down to fit maxCols x maxRows cells and encodes
+// it for the protocol. The id, of which only the lower 24 bits are used,
+// identifies the image in the terminal.
+func NewImage(protocol Protocol, id uint32, img image.Image, maxCols, maxRows int) (Image, error) {
+	id &= 0xffffff
+	if id == 0 {
+		id = 1
+	}
+
+	maxRows = min(maxRows, MaxRows)
+	if maxCols < 1 || maxRows < 1 {
+		return Image{}, fmt.Errorf("no room for the image")
+	}
+
+	b := img.Bounds()
+	if b.Empty() {
+		return Image{}, fmt.Errorf("empty image")
+	}
+
+	cellWidth, cellHeight := cellPixels()
+
+	width, height := b.Dx(), b.Dy()
+	scale := min(1,
+		float64(maxCols*cellWidth)/float64(width),
+		float64(maxRows*cellHeight)/float64(height),
+	)
+	width = max(1, int(float64(width)*scale))
+	height = max(1, int(float64(height)*scale))
+
+	i := Image{
+		Id:       id,
+		Cols:     (width + cellWidth - 1) / cellWidth,
+		Rows:     (height + cellHeight - 1) / cellHeight,
+		Protocol: protocol,
+	}
+
+	switch protocol {
+	case ProtocolKitty:
+		buf := bytes.Buffer{}
+		if err := png.Encode(&buf, resize(img, width, height)); err != nil {
+			return Image{}, err
+		}
+		i.data = buf.Bytes()
+
+	case ProtocolSixel:
+		i.sixels = encodeSixel(resize(img, width, height), cellHeight)
+
+	default:
+		return Image{}, fmt.Errorf("images are not supported by protocol %q", protocol)
+	}
+
+	return i, nil
+}
+
+// cellPixels returns the size of a terminal cell in pixels, approximated if
+// the terminal does not report it.
+func cellPixels() (int, int) {
+	if width, height, ok := cellSize(); ok {
+		return width, height
+	}
+
+	return cellWidth, cellHeight
+}
+
+// resize scales the image with the nearest neighbour.
+func resize(img image.Image, width, height int) image.Image {
+	b := img.Bounds()
+	if b.Dx() == width && b.Dy() == height {
+		return img
+	}
+
+	out := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		sy := b.Min.Y + y*b.Dy()/height
+		for x := 0; x < width; x++ {
+			sx := b.Min.X + x*b.Dx()/width
+			out.Set(x, y, img.At(sx, sy))
+		}
+	}
+
+	return out
+}
+
+// Transmit sends the image to the terminal and places it virtually so the
+// placeholders returned by Lines show it. The image is written at once so it
+// is not interleaved with other writes to an Output. Sixel images are drawn
+// by their lines, so nothing is sent for them.
+func (i Image) Transmit(w io.Writer) error {
+	if i.Protocol != ProtocolKitty {
+		return nil
+	}
+
+	buf := bytes.Buffer{}
+	payload := base64.StdEncoding.EncodeToString(i.data)
+
+	for first := true; first || payload != ""; first = false {
+		chunk := payload[:min(chunkSize, len(payload))]
+		payload = payload[len(chunk):]
+
+		more := 0
+		if payload != "" {
+			more = 1
+		}
+
+		keys := fmt.Sprintf("q=2,m=%d", more)
+		if first {
+			keys = fmt.Sprintf("a=T,U=1,f=100,i=%d,c=%d,r=%d,%s", i.Id, i.Cols, i.Rows, keys)
+		}
+
+		fmt.Fprintf(&buf, "\x1b_G%s;%s\x1b\\", keys, chunk)
+	}
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// Output is the terminal the program renders to. Its writes are serialized
+// so images transmitted in the background are written between frames of the
+// renderer, not in the middle of one.
+type Output struct {
+	*os.File
+	mutex sync.Mutex
+}
+
+func NewOutput(f *os.File) *Output {
+	return &Output{File: f}
+}
+
+func (o *Output) Write(p []byte) (int, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	return o.File.Write(p)
+}
+
+func (o *Output) WriteString(s string) (int, error) {
+	return o.Write([]byte(s))
+}
+
+// Lines returns the placeholder cells showing the image, one string per row.
+// Only the first cell of a row holds diacritics, the terminal infers the
+// columns of the following ones.
+//
+// Sixel rows are blank cells, which give the width of the row and clear it,
+// with the part of the image drawn over them. The cursor is restored after
+// drawing as terminals move it below the graphics.
+func (i Image) Lines() []string {
+	if i.Protocol == ProtocolSixel {
+		blank := strings.Repeat(" ", i.Cols)
+
+		lines := make([]string, len(i.sixels))
+		for row, sixel := range i.sixels {
+			lines[row] = ansi.SaveCursor + blank + ansi.RestoreCursor +
+				sixel +
+				ansi.RestoreCursor + ansi.CursorRight(i.Cols)
+		}
+
+		return lines
+	}
+
+	color := fmt.Sprintf("\x1b[38;2;%d;%d;%dm", i.Id>>16&0xff, i.Id>>8&0xff, i.Id&0xff)
+	rest := strings.Repeat(string(placeholder), i.Cols-1)
+
+	lines := make([]string, i.Rows)
+	for row := range lines {
+		lines[row] = color +
+			string([]rune{placeholder, diacritics[row], diacritics[0]}) +
+			rest +
+			"\x1b[39m"
+	}
+
+	return lines
+}
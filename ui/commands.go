@@ -0,0 +1,18 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prgrs/clickup/api"
+)
+
+type TaskEventMsg api.TaskEvent
+
+// WaitForTaskEventCmd waits for the next task event pushed by the webhook.
+// It returns nil if the webhook receiver is disabled.
+func WaitForTaskEventCmd(events <-chan api.TaskEvent) tea.Cmd {
+	if events == nil {
+		return nil
+	}
+
+	return func() tea.Msg { return TaskEventMsg(<-events) }
+}
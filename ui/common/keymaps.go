@@ -15,8 +15,8 @@ func NewEmptyHelp() help.KeyMap {
 }
 
 var KeyBindingBack = key.NewBinding(
-	key.WithKeys("escape"),
-	key.WithHelp("escape", "back to previous view"),
+	key.WithKeys("esc"),
+	key.WithHelp("esc", "back to previous view"),
 )
 
 type Help struct {
@@ -0,0 +1,37 @@
+package common
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// PromptMsg opens a dialog asking the user for a value or a confirmation.
+type PromptMsg struct {
+	Title string
+	// Input shows a text input filled with Value. Without it the user only
+	// confirms.
+	Input bool
+	Value string
+	// OnSubmit is called with the entered value once the dialog is
+	// confirmed. It is not called if the dialog is cancelled.
+	OnSubmit func(value string) tea.Cmd
+}
+
+func PromptCmd(p PromptMsg) tea.Cmd {
+	return func() tea.Msg { return p }
+}
+
+// InputCmd asks for a value prefilled with value.
+func InputCmd(title string, value string, onSubmit func(value string) tea.Cmd) tea.Cmd {
+	return PromptCmd(PromptMsg{
+		Title:    title,
+		Input:    true,
+		Value:    value,
+		OnSubmit: onSubmit,
+	})
+}
+
+// ConfirmCmd asks for a confirmation before onConfirm is called.
+func ConfirmCmd(title string, onConfirm func() tea.Cmd) tea.Cmd {
+	return PromptCmd(PromptMsg{
+		Title:    title,
+		OnSubmit: func(string) tea.Cmd { return onConfirm() },
+	})
+}
@@ -0,0 +1,60 @@
+package common
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/prgrs/clickup/pkg/clickup"
+)
+
+// TagChip renders the tag name on the background of its color.
+func TagChip(tag clickup.TaskTag) string {
+	style := lipgloss.NewStyle().Padding(0, 1)
+	if tag.Tag_bg == "" {
+		return style.Reverse(true).Render(tag.Name)
+	}
+
+	// ClickUp often uses the same color for both, which makes the name
+	// unreadable in a terminal.
+	fg := tag.Tag_fg
+	if fg == "" || strings.EqualFold(fg, tag.Tag_bg) {
+		fg = contrastColor(tag.Tag_bg)
+	}
+
+	return style.
+		Background(lipgloss.Color(tag.Tag_bg)).
+		Foreground(lipgloss.Color(fg)).
+		Render(tag.Name)
+}
+
+// TagChips renders chips of the tags separated by spaces.
+func TagChips(tags []clickup.TaskTag) string {
+	chips := make([]string, len(tags))
+	for i, tag := range tags {
+		chips[i] = TagChip(tag)
+	}
+
+	return strings.Join(chips, " ")
+}
+
+// contrastColor returns black or white, whichever is readable on the hex
+// color.
+func contrastColor(hex string) string {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) != 6 {
+		return "#ffffff"
+	}
+
+	rgb, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return "#ffffff"
+	}
+
+	r, g, b := rgb>>16&0xff, rgb>>8&0xff, rgb&0xff
+	if 299*r+587*g+114*b > 128000 {
+		return "#000000"
+	}
+
+	return "#ffffff"
+}
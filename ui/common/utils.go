@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/exec"
 	"runtime"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/log"
@@ -23,6 +24,16 @@ func OpenUrlInWebBrowser(url string) error {
 	}
 }
 
+// ProgressBar renders done out of total as a bar of the given width.
+func ProgressBar(width int, done int, total int) string {
+	if total == 0 || width <= 0 {
+		return ""
+	}
+
+	filled := width * done / total
+	return "[" + strings.Repeat("#", filled) + strings.Repeat("-", width-filled) + "]"
+}
+
 type EditorFinishedMsg struct {
 	Id   string
 	Data interface{}
@@ -41,6 +52,9 @@ func OpenEditor(id string, data string) tea.Cmd {
 	}
 
 	execCmd := exec.Command(editor, tmpfileName)
+	// The editor needs the terminal itself, not the output of the program
+	// wrapping it.
+	execCmd.Stdout = os.Stdout
 	return tea.ExecProcess(execCmd, func(err error) tea.Msg {
 		defer os.Remove(tmpfileName) // Clean up the file afterwards
 
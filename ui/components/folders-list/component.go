@@ -1,9 +1,13 @@
 package folderslist
 
 import (
+	"slices"
+
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/log"
+	"github.com/prgrs/clickup/api"
 	"github.com/prgrs/clickup/pkg/clickup"
 	"github.com/prgrs/clickup/ui/common"
 	listitem "github.com/prgrs/clickup/ui/components/list-item"
@@ -19,6 +23,9 @@ type Model struct {
 	log     *log.Logger
 	folders []clickup.Folder
 	keyMap  KeyMap
+	spaceId string
+
+	showArchived bool
 
 	Selected clickup.Folder
 }
@@ -32,26 +39,33 @@ func (m Model) Id() common.Id {
 }
 
 func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
+	delegate := list.NewDefaultDelegate()
+	delegate.Styles = ctx.Style.ListItems
+
 	l := list.New([]list.Item{},
-		list.NewDefaultDelegate(),
+		delegate,
 		0, 0)
 
 	l.KeyMap.Quit.Unbind()
 	l.KeyMap.CursorUp.Unbind()
 	l.KeyMap.CursorDown.Unbind()
 
+	l.Styles.Title = ctx.Style.ListTitle
 	l.SetShowHelp(false)
 	l.Title = "Folders"
 
 	log := common.NewLogger(logger, common.ResourceTypeRegistry.COMPONENT, id)
 
+	keyMap := DefaultKeyMap()
+	ctx.KeyBindings.Register(id, keyMap.Bindings(), id)
+
 	return Model{
 		id:       id,
 		list:     l,
 		ctx:      ctx,
 		Selected: clickup.Folder{},
 		folders:  []clickup.Folder{},
-		keyMap:   DefaultKeyMap(),
+		keyMap:   keyMap,
 		log:      log,
 	}
 }
@@ -59,7 +73,7 @@ func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
 func (m *Model) SetList(folders []clickup.Folder) {
 	m.log.Info("Synchronizing list")
 	m.folders = folders
-	items := NewListItem(folders)
+	items := NewListItem(folders, m.ctx.Style.Archived)
 	m.list.SetItems(items)
 }
 
@@ -94,7 +108,25 @@ func (m *Model) SetSize(s common.Size) {
 func (m *Model) SpaceChanged(id string) error {
 	m.log.Infof("Received: SpaceChangedMsg: %s", id)
 
-	folders, err := m.ctx.Api.GetFolders(id)
+	folders, err := m.fetchFolders(id)
+	if err != nil {
+		return err
+	}
+
+	m.spaceId = id
+	m.SetList(folders)
+	return nil
+}
+
+// SetShowArchived sets whether archived folders are listed and reloads the
+// folders of the current space.
+func (m *Model) SetShowArchived(show bool) error {
+	m.showArchived = show
+	if m.spaceId == "" {
+		return nil
+	}
+
+	folders, err := m.fetchFolders(m.spaceId)
 	if err != nil {
 		return err
 	}
@@ -103,10 +135,55 @@ func (m *Model) SpaceChanged(id string) error {
 	return nil
 }
 
-func NewListItem(items []clickup.Folder) []list.Item {
+// fetchFolders returns folders of the space, archived ones included if they
+// are shown, followed by a pseudo folder holding its folderless lists if
+// there are any.
+func (m *Model) fetchFolders(spaceId string) ([]clickup.Folder, error) {
+	folders, err := m.ctx.Api.GetFolders(spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	lists, err := m.ctx.Api.GetFolderlessLists(spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.showArchived {
+		archived, err := m.ctx.Api.GetArchivedFolders(spaceId)
+		if err != nil {
+			return nil, err
+		}
+		folders = slices.Concat(folders, archived)
+
+		archivedLists, err := m.ctx.Api.GetArchivedLists(api.FolderlessFolder(spaceId).Id)
+		if err != nil {
+			return nil, err
+		}
+		lists = slices.Concat(lists, archivedLists)
+	}
+
+	if len(lists) > 0 {
+		// cached slices are shared so the pseudo folder goes to a copy
+		folders = append(slices.Clip(folders), api.FolderlessFolder(spaceId))
+	}
+
+	return folders, nil
+}
+
+// NewListItem makes items of the folders rendering names of archived ones
+// with the archived style.
+func NewListItem(items []clickup.Folder, archived lipgloss.Style) []list.Item {
 	result := make([]list.Item, len(items))
 	for i, v := range items {
-		result[i] = listitem.NewItem(v.Name, v.Id, v)
+		title, desc := v.Name, v.Id
+		if _, ok := api.ParseFolderlessFolderId(v.Id); ok {
+			desc = "lists without a folder"
+		}
+		if v.Archived {
+			title, desc = archived.Render(v.Name), desc+" (archived)"
+		}
+		result[i] = listitem.NewItem(title, desc, v)
 	}
 	return result
 }
@@ -17,6 +17,9 @@ func (m Model) Help() help.KeyMap {
 					m.keyMap.CursorDown,
 					m.keyMap.CursorDownAndSelect,
 					m.keyMap.Select,
+					m.keyMap.Create,
+					m.keyMap.Rename,
+					m.keyMap.Delete,
 				},
 			)
 		},
@@ -28,5 +31,5 @@ func (m Model) Help() help.KeyMap {
 				m.keyMap.Select,
 			)
 		},
-	).With(common.KeyBindingBack)
+	)
 }
@@ -3,8 +3,11 @@ package folderslist
 import (
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prgrs/clickup/api"
 	"github.com/prgrs/clickup/pkg/clickup"
+	"github.com/prgrs/clickup/ui/common"
 	listitem "github.com/prgrs/clickup/ui/components/list-item"
+	"github.com/prgrs/clickup/ui/keybindings"
 )
 
 type KeyMap struct {
@@ -13,6 +16,9 @@ type KeyMap struct {
 	CursorDown          key.Binding
 	CursorDownAndSelect key.Binding
 	Select              key.Binding
+	Create              key.Binding
+	Rename              key.Binding
+	Delete              key.Binding
 }
 
 func DefaultKeyMap() KeyMap {
@@ -31,20 +37,101 @@ func DefaultKeyMap() KeyMap {
 		),
 		CursorDownAndSelect: key.NewBinding(
 			key.WithKeys("J", "shift+down"),
-			key.WithHelp("J, down", "down and select"),
+			key.WithHelp("J, shift+down", "down and select"),
 		),
 		Select: key.NewBinding(
 			key.WithKeys("enter"),
 			key.WithHelp("enter", "select"),
 		),
+		Create: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", "create folder"),
+		),
+		Rename: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "rename folder"),
+		),
+		Delete: key.NewBinding(
+			key.WithKeys("D"),
+			key.WithHelp("D", "delete folder"),
+		),
+	}
+}
+
+func (km *KeyMap) Bindings() keybindings.Bindings {
+	return keybindings.Bindings{
+		"cursor_up":              &km.CursorUp,
+		"cursor_up_and_select":   &km.CursorUpAndSelect,
+		"cursor_down":            &km.CursorDown,
+		"cursor_down_and_select": &km.CursorDownAndSelect,
+		"select":                 &km.Select,
+		"create":                 &km.Create,
+		"rename":                 &km.Rename,
+		"delete":                 &km.Delete,
 	}
 }
 
 func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
+	if action, ok := keybindings.Match(msg, m.keyMap.Bindings()); ok {
+		return m.handleAction(action)
+	}
+
+	return nil
+}
+
+// Handlers returns the handler of the actions of the list.
+func (m *Model) Handlers() keybindings.Handlers {
+	return keybindings.Handlers{id: m.handleAction}
+}
+
+// handleAction runs the action of the list by its name.
+func (m *Model) handleAction(action string) tea.Cmd {
 	var cmds []tea.Cmd
 
-	switch {
-	case key.Matches(msg, m.keyMap.Select):
+	switch action {
+	case "create":
+		if m.spaceId == "" {
+			break
+		}
+		spaceId := m.spaceId
+		return common.InputCmd("New folder", "", func(name string) tea.Cmd {
+			m.log.Info("Creating folder", "name", name)
+			if _, err := m.ctx.Api.CreateFolder(spaceId, name); err != nil {
+				return common.ErrCmd(err)
+			}
+			return m.reload()
+		})
+
+	case "rename":
+		folder, ok := m.highlightedFolder()
+		if !ok {
+			break
+		}
+		return common.InputCmd("Rename folder", folder.Name, func(name string) tea.Cmd {
+			m.log.Info("Renaming folder", "id", folder.Id, "name", name)
+			if _, err := m.ctx.Api.RenameFolder(folder, name); err != nil {
+				return common.ErrCmd(err)
+			}
+			return m.reload()
+		})
+
+	case "delete":
+		folder, ok := m.highlightedFolder()
+		if !ok {
+			break
+		}
+		return common.ConfirmCmd("Delete folder "+folder.Name+" with all its lists?", func() tea.Cmd {
+			m.log.Info("Deleting folder", "id", folder.Id)
+			if err := m.ctx.Api.DeleteFolder(folder); err != nil {
+				return common.ErrCmd(err)
+			}
+			if m.Selected.Id == folder.Id {
+				m.Selected = clickup.Folder{}
+			}
+			return m.reload()
+		})
+
+	case "select":
 		if m.list.SelectedItem() == nil {
 			m.log.Info("List is empty")
 			break
@@ -57,10 +144,10 @@ func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
 		m.Selected = selected
 		return FolderChangedCmd(selected.Id)
 
-	case key.Matches(msg, m.keyMap.CursorUp):
+	case "cursor_up":
 		m.list.CursorUp()
 
-	case key.Matches(msg, m.keyMap.CursorUpAndSelect):
+	case "cursor_up_and_select":
 		m.list.CursorUp()
 		if m.list.SelectedItem() == nil {
 			m.log.Info("List is empty")
@@ -74,10 +161,10 @@ func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
 		m.Selected = selected
 		return FolderPreviewCmd(selected.Id)
 
-	case key.Matches(msg, m.keyMap.CursorDown):
+	case "cursor_down":
 		m.list.CursorDown()
 
-	case key.Matches(msg, m.keyMap.CursorDownAndSelect):
+	case "cursor_down_and_select":
 		m.list.CursorDown()
 		if m.list.SelectedItem() == nil {
 			m.log.Info("List is empty")
@@ -94,3 +181,28 @@ func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
 
 	return tea.Batch(cmds...)
 }
+
+// highlightedFolder returns the highlighted folder unless it is the pseudo
+// folder of folderless lists which can not be changed.
+func (m *Model) highlightedFolder() (clickup.Folder, bool) {
+	if m.list.SelectedItem() == nil {
+		m.log.Info("List is empty")
+		return clickup.Folder{}, false
+	}
+
+	folder := m.list.SelectedItem().(listitem.Item).Data().(clickup.Folder)
+	if _, ok := api.ParseFolderlessFolderId(folder.Id); ok {
+		m.log.Info("Folderless lists can not be changed")
+		return clickup.Folder{}, false
+	}
+
+	return folder, true
+}
+
+func (m *Model) reload() tea.Cmd {
+	if err := m.SpaceChanged(m.spaceId); err != nil {
+		return common.ErrCmd(err)
+	}
+
+	return nil
+}
@@ -1,8 +1,11 @@
 package listslist
 
 import (
+	"slices"
+
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/log"
 	"github.com/prgrs/clickup/pkg/clickup"
 	"github.com/prgrs/clickup/ui/common"
@@ -20,6 +23,9 @@ type Model struct {
 	lists  []clickup.List
 	keyMap KeyMap
 
+	folderId     string
+	showArchived bool
+
 	Selected clickup.List
 }
 
@@ -28,26 +34,33 @@ func (m Model) Id() common.Id {
 }
 
 func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
+	delegate := list.NewDefaultDelegate()
+	delegate.Styles = ctx.Style.ListItems
+
 	l := list.New([]list.Item{},
-		list.NewDefaultDelegate(),
+		delegate,
 		0, 0)
 
 	l.KeyMap.Quit.Unbind()
 	l.KeyMap.CursorUp.Unbind()
 	l.KeyMap.CursorDown.Unbind()
 
+	l.Styles.Title = ctx.Style.ListTitle
 	l.SetShowHelp(false)
 	l.Title = "Lists"
 
 	log := common.NewLogger(logger, common.ResourceTypeRegistry.COMPONENT, id)
 
+	keyMap := DefaultKeyMap()
+	ctx.KeyBindings.Register(id, keyMap.Bindings(), id)
+
 	return Model{
 		id:       id,
 		list:     l,
 		ctx:      ctx,
 		Selected: clickup.List{},
 		lists:    []clickup.List{},
-		keyMap:   DefaultKeyMap(),
+		keyMap:   keyMap,
 		log:      log,
 	}
 }
@@ -59,7 +72,7 @@ func (m Model) KeyMap() KeyMap {
 func (m *Model) SetList(lists []clickup.List) {
 	m.log.Info("Synchronizing list")
 	m.lists = lists
-	items := NewListItem(lists)
+	items := NewListItem(lists, m.ctx.Style.Archived)
 	m.list.SetItems(items)
 }
 
@@ -92,19 +105,64 @@ func (m *Model) SetSize(size common.Size) {
 }
 
 func (m *Model) FolderChanged(id string) error {
-	lists, err := m.ctx.Api.GetLists(id)
+	lists, err := m.fetchLists(id)
 	if err != nil {
 		return err
 	}
+	m.folderId = id
 	m.SetList(lists)
 
 	return nil
 }
 
-func NewListItem(items []clickup.List) []list.Item {
+// SetShowArchived sets whether archived lists are listed and reloads the
+// lists of the current folder.
+func (m *Model) SetShowArchived(show bool) error {
+	m.showArchived = show
+	if m.folderId == "" {
+		return nil
+	}
+
+	return m.FolderChanged(m.folderId)
+}
+
+// Reload fetches the lists of the current folder again.
+func (m *Model) Reload() error {
+	if m.folderId == "" {
+		return nil
+	}
+
+	return m.FolderChanged(m.folderId)
+}
+
+func (m *Model) fetchLists(folderId string) ([]clickup.List, error) {
+	lists, err := m.ctx.Api.GetLists(folderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.showArchived {
+		return lists, nil
+	}
+
+	archived, err := m.ctx.Api.GetArchivedLists(folderId)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Concat(lists, archived), nil
+}
+
+// NewListItem makes items of the lists rendering names of archived ones with
+// the archived style.
+func NewListItem(items []clickup.List, archived lipgloss.Style) []list.Item {
 	result := make([]list.Item, len(items))
 	for i, v := range items {
-		result[i] = listitem.NewItem(v.Name, v.Id, v)
+		title, desc := v.Name, v.Id
+		if v.Archived {
+			title, desc = archived.Render(v.Name), desc+" (archived)"
+		}
+		result[i] = listitem.NewItem(title, desc, v)
 	}
 	return result
 }
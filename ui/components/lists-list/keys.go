@@ -4,7 +4,9 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/prgrs/clickup/pkg/clickup"
+	"github.com/prgrs/clickup/ui/common"
 	listitem "github.com/prgrs/clickup/ui/components/list-item"
+	"github.com/prgrs/clickup/ui/keybindings"
 )
 
 type KeyMap struct {
@@ -13,6 +15,10 @@ type KeyMap struct {
 	CursorDown          key.Binding
 	CursorDownAndSelect key.Binding
 	Select              key.Binding
+	Archive             key.Binding
+	Create              key.Binding
+	Rename              key.Binding
+	Delete              key.Binding
 }
 
 func DefaultKeyMap() KeyMap {
@@ -31,18 +37,118 @@ func DefaultKeyMap() KeyMap {
 		),
 		CursorDownAndSelect: key.NewBinding(
 			key.WithKeys("J", "shift+down"),
-			key.WithHelp("J, down", "down and select"),
+			key.WithHelp("J, shift+down", "down and select"),
 		),
 		Select: key.NewBinding(
 			key.WithKeys("enter"),
 			key.WithHelp("enter", "select"),
 		),
+		Archive: key.NewBinding(
+			key.WithKeys("X"),
+			key.WithHelp("X", "archive/unarchive list"),
+		),
+		Create: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", "create list"),
+		),
+		Rename: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "rename list"),
+		),
+		Delete: key.NewBinding(
+			key.WithKeys("D"),
+			key.WithHelp("D", "delete list"),
+		),
+	}
+}
+
+func (km *KeyMap) Bindings() keybindings.Bindings {
+	return keybindings.Bindings{
+		"cursor_up":              &km.CursorUp,
+		"cursor_up_and_select":   &km.CursorUpAndSelect,
+		"cursor_down":            &km.CursorDown,
+		"cursor_down_and_select": &km.CursorDownAndSelect,
+		"select":                 &km.Select,
+		"archive":                &km.Archive,
+		"create":                 &km.Create,
+		"rename":                 &km.Rename,
+		"delete":                 &km.Delete,
 	}
 }
 
 func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
-	switch {
-	case key.Matches(msg, m.keyMap.Select):
+	if action, ok := keybindings.Match(msg, m.keyMap.Bindings()); ok {
+		return m.handleAction(action)
+	}
+
+	return nil
+}
+
+// Handlers returns the handler of the actions of the list.
+func (m *Model) Handlers() keybindings.Handlers {
+	return keybindings.Handlers{id: m.handleAction}
+}
+
+// handleAction runs the action of the list by its name.
+func (m *Model) handleAction(action string) tea.Cmd {
+	switch action {
+	case "create":
+		if m.folderId == "" {
+			break
+		}
+		folderId := m.folderId
+		return common.InputCmd("New list", "", func(name string) tea.Cmd {
+			m.log.Info("Creating list", "name", name)
+			if _, err := m.ctx.Api.CreateList(folderId, name); err != nil {
+				return common.ErrCmd(err)
+			}
+			return m.reload()
+		})
+
+	case "rename":
+		if m.list.SelectedItem() == nil {
+			m.log.Info("List is empty")
+			break
+		}
+		selected := m.list.SelectedItem().(listitem.Item).Data().(clickup.List)
+		return common.InputCmd("Rename list", selected.Name, func(name string) tea.Cmd {
+			m.log.Info("Renaming list", "id", selected.Id, "name", name)
+			if _, err := m.ctx.Api.RenameList(selected, name); err != nil {
+				return common.ErrCmd(err)
+			}
+			return m.reload()
+		})
+
+	case "delete":
+		if m.list.SelectedItem() == nil {
+			m.log.Info("List is empty")
+			break
+		}
+		selected := m.list.SelectedItem().(listitem.Item).Data().(clickup.List)
+		return common.ConfirmCmd("Delete list "+selected.Name+" with all its tasks?", func() tea.Cmd {
+			m.log.Info("Deleting list", "id", selected.Id)
+			if err := m.ctx.Api.DeleteList(selected); err != nil {
+				return common.ErrCmd(err)
+			}
+			if m.Selected.Id == selected.Id {
+				m.Selected = clickup.List{}
+			}
+			return m.reload()
+		})
+
+	case "archive":
+		if m.list.SelectedItem() == nil {
+			m.log.Info("List is empty")
+			break
+		}
+		selected := m.list.SelectedItem().(listitem.Item).Data().(clickup.List)
+		m.log.Info("Archiving list", "id", selected.Id, "archived", !selected.Archived)
+		if _, err := m.ctx.Api.SetListArchived(selected, !selected.Archived); err != nil {
+			return common.ErrCmd(err)
+		}
+		return m.reload()
+
+	case "select":
 		if m.list.SelectedItem() == nil {
 			m.log.Info("List is empty")
 			break
@@ -55,10 +161,10 @@ func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
 		m.Selected = selected
 		return ListChangedCmd(m.Selected.Id)
 
-	case key.Matches(msg, m.keyMap.CursorDown):
+	case "cursor_down":
 		m.list.CursorDown()
 
-	case key.Matches(msg, m.keyMap.CursorDownAndSelect):
+	case "cursor_down_and_select":
 		m.list.CursorDown()
 		if m.list.SelectedItem() == nil {
 			m.log.Info("List is empty")
@@ -72,10 +178,10 @@ func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
 		m.Selected = selected
 		return ListPreviewCmd(m.Selected.Id)
 
-	case key.Matches(msg, m.keyMap.CursorUp):
+	case "cursor_up":
 		m.list.CursorUp()
 
-	case key.Matches(msg, m.keyMap.CursorUpAndSelect):
+	case "cursor_up_and_select":
 		m.list.CursorUp()
 		if m.list.SelectedItem() == nil {
 			m.log.Info("List is empty")
@@ -92,3 +198,11 @@ func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
 
 	return nil
 }
+
+func (m *Model) reload() tea.Cmd {
+	if err := m.Reload(); err != nil {
+		return common.ErrCmd(err)
+	}
+
+	return nil
+}
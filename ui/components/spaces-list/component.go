@@ -20,6 +20,8 @@ type Model struct {
 	spaces []clickup.Space
 	keyMap KeyMap
 
+	workspaceId string
+
 	Selected clickup.Space
 }
 
@@ -32,19 +34,26 @@ func (m Model) KeyMap() KeyMap {
 }
 
 func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
+	delegate := list.NewDefaultDelegate()
+	delegate.Styles = ctx.Style.ListItems
+
 	l := list.New([]list.Item{},
-		list.NewDefaultDelegate(),
+		delegate,
 		0, 0)
 
 	l.KeyMap.Quit.Unbind()
 	l.KeyMap.CursorUp.Unbind()
 	l.KeyMap.CursorDown.Unbind()
 
+	l.Styles.Title = ctx.Style.ListTitle
 	l.SetShowHelp(false)
 	l.Title = "Spaces"
 
 	log := common.NewLogger(logger, common.ResourceTypeRegistry.COMPONENT, id)
 
+	keyMap := DefaultKeyMap()
+	ctx.KeyBindings.Register(id, keyMap.Bindings(), id)
+
 	return Model{
 		id:       id,
 		list:     l,
@@ -52,7 +61,7 @@ func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
 		Selected: clickup.Space{},
 		spaces:   []clickup.Space{},
 		log:      log,
-		keyMap:   DefaultKeyMap(),
+		keyMap:   keyMap,
 	}
 }
 
@@ -99,6 +108,7 @@ func (m *Model) WorkspaceChanged(id string) error {
 		return err
 	}
 
+	m.workspaceId = id
 	m.SetList(spaces)
 	return nil
 }
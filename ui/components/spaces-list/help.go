@@ -17,6 +17,7 @@ func (m Model) Help() help.KeyMap {
 					m.keyMap.CursorDown,
 					m.keyMap.CursorDownAndSelect,
 					m.keyMap.Select,
+					m.keyMap.Create,
 				},
 			)
 		},
@@ -28,5 +29,5 @@ func (m Model) Help() help.KeyMap {
 				m.keyMap.Select,
 			)
 		},
-	).With(common.KeyBindingBack)
+	)
 }
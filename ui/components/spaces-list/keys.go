@@ -4,7 +4,9 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/prgrs/clickup/pkg/clickup"
+	"github.com/prgrs/clickup/ui/common"
 	listitem "github.com/prgrs/clickup/ui/components/list-item"
+	"github.com/prgrs/clickup/ui/keybindings"
 )
 
 type KeyMap struct {
@@ -13,6 +15,7 @@ type KeyMap struct {
 	CursorDown          key.Binding
 	CursorDownAndSelect key.Binding
 	Select              key.Binding
+	Create              key.Binding
 }
 
 func DefaultKeyMap() KeyMap {
@@ -31,18 +34,63 @@ func DefaultKeyMap() KeyMap {
 		),
 		CursorDownAndSelect: key.NewBinding(
 			key.WithKeys("J", "shift+down"),
-			key.WithHelp("J, down", "down and select"),
+			key.WithHelp("J, shift+down", "down and select"),
 		),
 		Select: key.NewBinding(
 			key.WithKeys("enter"),
 			key.WithHelp("enter", "select"),
 		),
+		Create: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", "create space"),
+		),
+	}
+}
+
+func (km *KeyMap) Bindings() keybindings.Bindings {
+	return keybindings.Bindings{
+		"cursor_up":              &km.CursorUp,
+		"cursor_up_and_select":   &km.CursorUpAndSelect,
+		"cursor_down":            &km.CursorDown,
+		"cursor_down_and_select": &km.CursorDownAndSelect,
+		"select":                 &km.Select,
+		"create":                 &km.Create,
 	}
 }
 
 func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
-	switch {
-	case key.Matches(msg, m.keyMap.Select):
+	if action, ok := keybindings.Match(msg, m.keyMap.Bindings()); ok {
+		return m.handleAction(action)
+	}
+
+	return nil
+}
+
+// Handlers returns the handler of the actions of the list.
+func (m *Model) Handlers() keybindings.Handlers {
+	return keybindings.Handlers{id: m.handleAction}
+}
+
+// handleAction runs the action of the list by its name.
+func (m *Model) handleAction(action string) tea.Cmd {
+	switch action {
+	case "create":
+		if m.workspaceId == "" {
+			break
+		}
+		workspaceId := m.workspaceId
+		return common.InputCmd("New space", "", func(name string) tea.Cmd {
+			m.log.Info("Creating space", "name", name)
+			if _, err := m.ctx.Api.CreateSpace(workspaceId, name); err != nil {
+				return common.ErrCmd(err)
+			}
+			if err := m.WorkspaceChanged(workspaceId); err != nil {
+				return common.ErrCmd(err)
+			}
+			return nil
+		})
+
+	case "select":
 		if m.list.SelectedItem() == nil {
 			m.log.Info("List is empty")
 			break
@@ -55,10 +103,10 @@ func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
 		m.Selected = selected
 		return SpaceChangedCmd(selected.Id)
 
-	case key.Matches(msg, m.keyMap.CursorDown):
+	case "cursor_down":
 		m.list.CursorDown()
 
-	case key.Matches(msg, m.keyMap.CursorDownAndSelect):
+	case "cursor_down_and_select":
 		m.list.CursorDown()
 		if m.list.SelectedItem() == nil {
 			m.log.Info("List is empty")
@@ -72,10 +120,10 @@ func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
 		m.Selected = selected
 		return SpacePreviewCmd(selected.Id)
 
-	case key.Matches(msg, m.keyMap.CursorUp):
+	case "cursor_up":
 		m.list.CursorUp()
 
-	case key.Matches(msg, m.keyMap.CursorUpAndSelect):
+	case "cursor_up_and_select":
 		m.list.CursorUp()
 		if m.list.SelectedItem() == nil {
 			m.log.Info("List is empty")
@@ -1,7 +1,8 @@
 package tabletasks
 
 import (
-	"github.com/charmbracelet/bubbles/key"
+	"slices"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/log"
@@ -11,7 +12,11 @@ import (
 	"github.com/prgrs/clickup/ui/context"
 )
 
-const id = "tasks-table"
+const (
+	id           = "tasks-table"
+	modeFilter   = id + "/filter"
+	modeFiltered = id + "/filtered"
+)
 
 type Model struct {
 	id             common.Id
@@ -40,8 +45,10 @@ type Column struct {
 	Hidden bool
 }
 
+// GetTasks returns a copy of the shown tasks. The tasks are shared with the
+// cache, so they must not be modified in place.
 func (m Model) GetTasks() []clickup.Task {
-	return m.tasks
+	return slices.Clone(m.tasks)
 }
 
 func (m Model) GetSize() common.Size {
@@ -95,6 +102,10 @@ func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
 		},
 	}
 	columnsVisible := []Column{
+		{
+			Column: table.NewColumn("blocked", "", 1),
+			Hidden: false,
+		},
 		{
 			Column: table.NewFlexColumn("name", "Name", 70),
 			Hidden: false,
@@ -104,6 +115,10 @@ func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
 				WithStyle(lipgloss.NewStyle().Align(lipgloss.Center)),
 			Hidden: false,
 		},
+		{
+			Column: table.NewFlexColumn("tags", "Tags", 15),
+			Hidden: false,
+		},
 	}
 	columns := append(columnsVisible, columnsHidden...)
 
@@ -114,13 +129,13 @@ func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
 
 	size := common.NewEmptySize()
 
-	tableKeyMap := table.DefaultKeyMap()
-	tableKeyMap.RowSelectToggle = key.NewBinding(
-		key.WithKeys(" "),
-	)
+	keyMap := DefaultKeyMap()
+	ctx.KeyBindings.Register(id, keyMap.Bindings(), id)
+	ctx.KeyBindings.Register(id, keyMap.BindingsFilter(), modeFilter)
+	ctx.KeyBindings.Register(id, keyMap.BindingsFiltered(), modeFiltered)
 
 	t := table.New(tableColumns).
-		WithKeyMap(tableKeyMap).
+		WithKeyMap(keyMap.KeyMap).
 		WithTargetWidth(size.Width).
 		SelectableRows(true).
 		WithSelectedText(" ", "✓").
@@ -130,10 +145,7 @@ func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
 			lipgloss.NewStyle().
 				Align(lipgloss.Left),
 		).
-		HighlightStyle(
-			lipgloss.NewStyle().
-				Bold(true).
-				Foreground(lipgloss.Color("212")))
+		HighlightStyle(ctx.Style.TableHighlight)
 
 	log := common.NewLogger(logger, common.ResourceTypeRegistry.COMPONENT, id)
 
@@ -150,7 +162,7 @@ func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
 		Hidden:         false,
 		log:            log,
 		ifBorders:      true,
-		keyMap:         DefaultKeyMap(),
+		keyMap:         keyMap,
 	}
 }
 
@@ -198,6 +210,18 @@ func (m Model) GetHighlightedTask() *clickup.Task {
 	return &m.tasks[index]
 }
 
+// HighlightTask moves the highlight to the task and reports if the table
+// contains it.
+func (m *Model) HighlightTask(id string) bool {
+	i := slices.IndexFunc(m.tasks, func(t clickup.Task) bool { return t.Id == id })
+	if i < 0 {
+		return false
+	}
+
+	m.table = m.table.WithHighlightedRow(i)
+	return true
+}
+
 func (m Model) GetSelectedTasks() []*clickup.Task {
 	rows := m.table.SelectedRows()
 	tasks := make([]*clickup.Task, len(rows))
@@ -225,6 +249,11 @@ func (m Model) Init() tea.Cmd {
 	return nil
 }
 
+// IsFiltering reports whether keys are typed into the filter.
+func (m Model) IsFiltering() bool {
+	return m.table.GetIsFilterInputFocused()
+}
+
 func (m Model) GetFocused() bool {
 	return m.Focused
 }
@@ -268,6 +297,11 @@ func (m Model) TabChanged(tabId string) (Model, tea.Cmd) {
 func (m *Model) SetTasks(tasks []clickup.Task) {
 	m.tasks = tasks
 	items := taskListToRows(tasks, m.GetColumnsKey())
+	for i := range tasks {
+		if tasks[i].Archived {
+			items[i] = items[i].WithStyle(m.ctx.Style.Archived)
+		}
+	}
 	m.table = m.table.WithRows(items)
 	m.log.Info("Table synchonized", "size", len(m.table.GetVisibleRows()))
 }
@@ -5,6 +5,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/evertras/bubble-table/table"
 	"github.com/prgrs/clickup/ui/common"
+	"github.com/prgrs/clickup/ui/keybindings"
 )
 
 type KeyMap struct {
@@ -19,7 +20,7 @@ func DefaultKeyMap() KeyMap {
 		KeyMap: table.KeyMap{
 			RowDown:         common.KeyBindingWithHelp(km.RowDown, "down"),
 			RowUp:           common.KeyBindingWithHelp(km.RowUp, "up"),
-			RowSelectToggle: common.KeyBindingWithHelp(km.RowSelectToggle, "select"),
+			RowSelectToggle: common.KeyBindingWithHelp(key.NewBinding(key.WithKeys(" ")), "select"),
 			PageDown:        common.KeyBindingWithHelp(km.PageDown, "next page"),
 			PageUp:          common.KeyBindingWithHelp(km.PageUp, "previous page"),
 			PageFirst:       common.KeyBindingWithHelp(km.PageFirst, "first page"),
@@ -37,14 +38,62 @@ func DefaultKeyMap() KeyMap {
 	}
 }
 
+func (km *KeyMap) Bindings() keybindings.Bindings {
+	return keybindings.Bindings{
+		"row_down":          &km.RowDown,
+		"row_up":            &km.RowUp,
+		"row_select_toggle": &km.RowSelectToggle,
+		"page_down":         &km.PageDown,
+		"page_up":           &km.PageUp,
+		"page_first":        &km.PageFirst,
+		"page_last":         &km.PageLast,
+		"filter":            &km.Filter,
+		"scroll_right":      &km.ScrollRight,
+		"scroll_left":       &km.ScrollLeft,
+		"select":            &km.Select,
+	}
+}
+
+// BindingsFilter returns bindings active while typing into the filter.
+func (km *KeyMap) BindingsFilter() keybindings.Bindings {
+	return keybindings.Bindings{
+		"filter_blur": &km.FilterBlur,
+	}
+}
+
+// BindingsFiltered returns bindings active once the filter is blurred.
+func (km *KeyMap) BindingsFiltered() keybindings.Bindings {
+	return keybindings.Bindings{
+		"filter_clear": &km.FilterClear,
+	}
+}
+
 func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
 	var (
 		cmd  tea.Cmd
 		cmds []tea.Cmd
 	)
 
-	switch {
-	case key.Matches(msg, m.keyMap.Select):
+	// the table handles its own keys
+	if action, ok := keybindings.Match(msg, m.keyMap.Bindings()); ok && action == "select" {
+		cmds = append(cmds, m.handleAction(action))
+	}
+
+	m.table, cmd = m.table.Update(msg)
+	cmds = append(cmds, cmd)
+
+	return tea.Batch(cmds...)
+}
+
+// Handlers returns the handler of the actions of the table.
+func (m *Model) Handlers() keybindings.Handlers {
+	return keybindings.Handlers{id: m.handleAction}
+}
+
+// handleAction runs the action of the table by its name.
+func (m *Model) handleAction(action string) tea.Cmd {
+	switch action {
+	case "select":
 		index := m.table.GetHighlightedRowIndex()
 		if m.table.TotalRows() == 0 {
 			m.log.Info("Table is empty")
@@ -52,11 +101,41 @@ func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
 		}
 		taskId := m.tasks[index].Id
 		m.log.Infof("Receive enter: %d", index)
-		cmds = append(cmds, TaskSelectedCmd(taskId))
-	}
+		return TaskSelectedCmd(taskId)
 
-	m.table, cmd = m.table.Update(msg)
-	cmds = append(cmds, cmd)
+	case "row_down":
+		m.table = m.table.WithHighlightedRow(m.table.GetHighlightedRowIndex() + 1)
 
-	return tea.Batch(cmds...)
+	case "row_up":
+		m.table = m.table.WithHighlightedRow(max(m.table.GetHighlightedRowIndex()-1, 0))
+
+	case "page_down":
+		m.table = m.table.PageDown()
+
+	case "page_up":
+		m.table = m.table.PageUp()
+
+	case "page_first":
+		m.table = m.table.PageFirst()
+
+	case "page_last":
+		m.table = m.table.PageLast()
+
+	case "scroll_right":
+		m.table = m.table.ScrollRight()
+
+	case "scroll_left":
+		m.table = m.table.ScrollLeft()
+
+	case "filter":
+		m.table = m.table.StartFilterTyping()
+
+	case "filter_clear":
+		m.table = m.table.WithFilterInputValue("")
+
+	default:
+		m.log.Info("Action is only available by its key", "action", action)
+	}
+
+	return nil
 }
@@ -3,8 +3,12 @@ package tabletasks
 import (
 	"github.com/evertras/bubble-table/table"
 	"github.com/prgrs/clickup/pkg/clickup"
+	"github.com/prgrs/clickup/ui/common"
 )
 
+// blockedMark is shown for tasks waiting on other tasks.
+const blockedMark = "⊘"
+
 func taskListToRows(tasks []clickup.Task, columns []string) []table.Row {
 	rows := make([]table.Row, len(tasks))
 	for i, task := range tasks {
@@ -25,14 +29,19 @@ func taskToRow(task clickup.Task, columns []string) table.Row {
 			values[column] = task.Url
 		case "id":
 			values[column] = task.Id
+		case "blocked":
+			values[column] = ""
+			if task.IsBlocked() {
+				values[column] = blockedMark
+			}
+		case "tags":
+			values[column] = common.TagChips(task.Tags)
 			// After migration from charm to evertras/bubble-table I temporary removed all columns
 			// except "status" and "name" since they are not supported yet. See autoColumns feature
 			// case "assignee":
 			// 	values = append(values, task.GetAssignees())
 			// case "list":
 			// 	values = append(values, task.List.String())
-			// case "tags":
-			// 	values = append(values, task.GetTags())
 			// case "folder":
 			// 	values = append(values, task.Folder.String())
 			// case "space":
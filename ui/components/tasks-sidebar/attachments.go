@@ -0,0 +1,167 @@
+package taskssidebar
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prgrs/clickup/internal/config"
+	"github.com/prgrs/clickup/pkg/cache"
+	"github.com/prgrs/clickup/pkg/clickup"
+	"github.com/prgrs/clickup/ui/common"
+)
+
+// attachments returns files attached to the selected task. Deleted
+// attachments and folders are left out.
+func (m Model) attachments() []clickup.Attachment {
+	attachments := []clickup.Attachment{}
+	for _, a := range m.SelectedTask.Attachments {
+		if !a.Deleted && !a.IsFolder {
+			attachments = append(attachments, a)
+		}
+	}
+
+	return attachments
+}
+
+func (m Model) selectedAttachment() (clickup.Attachment, bool) {
+	i := m.itemCursor - len(m.checklistItems()) - len(m.references())
+	attachments := m.attachments()
+	if i < 0 || i >= len(attachments) {
+		return clickup.Attachment{}, false
+	}
+
+	return attachments[i], true
+}
+
+func (m Model) renderAttachments() string {
+	attachments := m.attachments()
+	if len(attachments) == 0 && m.attachmentStatus == "" {
+		return ""
+	}
+
+	s := strings.Builder{}
+	s.WriteString("\nAttachments\n")
+
+	offset := len(m.checklistItems()) + len(m.references())
+	for i, a := range attachments {
+		details := []string{}
+		if size, ok := a.GetSize(); ok {
+			details = append(details, cache.FormatBytes(size))
+		}
+		if a.User.Username != "" {
+			details = append(details, "by "+a.User.Username)
+		}
+
+		line := "  " + a.Title
+		if len(details) > 0 {
+			line += " (" + strings.Join(details, ", ") + ")"
+		}
+
+		if offset+i == m.itemCursor {
+			line = m.ctx.Style.TableHighlight.Render(line)
+		}
+
+		s.WriteString(line + "\n")
+	}
+
+	if m.attachmentStatus != "" {
+		s.WriteString("\n" + m.attachmentStatus + "\n")
+	}
+
+	return s.String()
+}
+
+// downloadAttachment saves the attachment under the cursor to the directory
+// set in the config in the background.
+func (m *Model) downloadAttachment() tea.Cmd {
+	a, ok := m.selectedAttachment()
+	if !ok {
+		return nil
+	}
+
+	dir, err := m.ctx.Config.Attachments.DownloadDir()
+	if err != nil {
+		return common.ErrCmd(err)
+	}
+
+	m.log.Info("Downloading attachment", "id", a.Id, "dir", dir)
+	m.setAttachmentStatus(fmt.Sprintf("Downloading %s...", a.Title))
+
+	api := m.ctx.Api
+	return func() tea.Msg {
+		path, err := api.DownloadAttachment(a, dir)
+		return AttachmentDownloadedMsg{Attachment: a, Path: path, Err: err}
+	}
+}
+
+// uploadAttachment asks for a path of a file and attaches it to the task in
+// the background.
+func (m *Model) uploadAttachment() tea.Cmd {
+	taskId := m.SelectedTask.Id
+	if taskId == "" {
+		return nil
+	}
+
+	var onSubmit func(value string) tea.Cmd
+	onSubmit = func(value string) tea.Cmd {
+		path, err := config.ExpandHome(strings.TrimSpace(value))
+		if err != nil {
+			return common.ErrCmd(err)
+		}
+
+		info, err := os.Stat(path)
+		switch {
+		case err != nil:
+			return common.InputCmd(fmt.Sprintf("Cannot read %q: %s", value, err), value, onSubmit)
+		case info.IsDir():
+			return common.InputCmd(fmt.Sprintf("%q is a directory", value), value, onSubmit)
+		}
+
+		m.log.Info("Uploading attachment", "taskId", taskId, "path", path)
+		m.setAttachmentStatus(fmt.Sprintf("Uploading %s...", filepath.Base(path)))
+
+		api := m.ctx.Api
+		return func() tea.Msg {
+			task, err := api.UploadAttachment(taskId, path)
+			return AttachmentUploadedMsg{Task: task, Path: path, Err: err}
+		}
+	}
+
+	return common.InputCmd("Path of the file to attach", "", onSubmit)
+}
+
+func (m *Model) handleAttachmentDownloaded(msg AttachmentDownloadedMsg) tea.Cmd {
+	if msg.Err != nil {
+		m.log.Error("Failed to download attachment", "id", msg.Attachment.Id, "error", msg.Err)
+		m.setAttachmentStatus(fmt.Sprintf("Downloading %s failed: %s", msg.Attachment.Title, msg.Err))
+		return nil
+	}
+
+	m.log.Info("Downloaded attachment", "id", msg.Attachment.Id, "path", msg.Path)
+	m.setAttachmentStatus("Saved to " + msg.Path)
+
+	return nil
+}
+
+func (m *Model) handleAttachmentUploaded(msg AttachmentUploadedMsg) tea.Cmd {
+	if msg.Err != nil {
+		m.log.Error("Failed to upload attachment", "path", msg.Path, "error", msg.Err)
+		m.setAttachmentStatus(fmt.Sprintf("Uploading %s failed: %s", filepath.Base(msg.Path), msg.Err))
+		return nil
+	}
+
+	m.log.Info("Uploaded attachment", "taskId", msg.Task.Id, "path", msg.Path)
+	m.attachmentStatus = "Attached " + filepath.Base(msg.Path)
+
+	return m.updateTask(msg.Task)
+}
+
+func (m *Model) setAttachmentStatus(status string) {
+	m.attachmentStatus = status
+	if err := m.render(false); err != nil {
+		m.log.Error("Failed to render task", "error", err)
+	}
+}
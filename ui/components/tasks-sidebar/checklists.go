@@ -0,0 +1,160 @@
+package taskssidebar
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prgrs/clickup/pkg/clickup"
+	"github.com/prgrs/clickup/ui/common"
+)
+
+const checklistProgressWidth = 20
+
+// checklistItem is an item with the checklist it belongs to.
+type checklistItem struct {
+	checklist clickup.Checklist
+	item      clickup.ChecklistItem
+}
+
+// checklistItems returns items of all checklists of the task in order they
+// are rendered.
+func (m Model) checklistItems() []checklistItem {
+	items := []checklistItem{}
+	for _, checklist := range m.SelectedTask.Checklists {
+		for _, item := range checklist.Items {
+			items = append(items, checklistItem{checklist, item})
+		}
+	}
+
+	return items
+}
+
+func (m Model) renderChecklists() string {
+	if len(m.SelectedTask.Checklists) == 0 {
+		return ""
+	}
+
+	s := strings.Builder{}
+	s.WriteString("\nChecklists\n")
+
+	i := 0
+	for _, checklist := range m.SelectedTask.Checklists {
+		resolved, total := checklist.Progress()
+		fmt.Fprintf(&s, "\n%s %s %d/%d\n",
+			checklist.Name,
+			common.ProgressBar(checklistProgressWidth, resolved, total),
+			resolved, total,
+		)
+
+		for _, item := range checklist.Items {
+			mark := "[ ]"
+			if item.Resolved {
+				mark = "[x]"
+			}
+
+			indent := "  "
+			if item.Parent != "" {
+				indent = "    "
+			}
+
+			line := fmt.Sprintf("%s%s %s", indent, mark, item.Name)
+			if i == m.itemCursor {
+				line = m.ctx.Style.TableHighlight.Render(line)
+			}
+
+			s.WriteString(line + "\n")
+			i++
+		}
+	}
+
+	return s.String()
+}
+
+func (m *Model) moveItemCursor(delta int) tea.Cmd {
+	count := m.itemCount()
+	if count == 0 {
+		return nil
+	}
+
+	m.itemCursor = (m.itemCursor + delta + count) % count
+	if err := m.render(false); err != nil {
+		return common.ErrCmd(err)
+	}
+
+	return nil
+}
+
+func (m *Model) toggleItem() tea.Cmd {
+	items := m.checklistItems()
+	if m.itemCursor >= len(items) {
+		return nil
+	}
+
+	selected := items[m.itemCursor]
+	m.log.Info("Toggling checklist item", "id", selected.item.Id, "resolved", !selected.item.Resolved)
+
+	task, err := m.ctx.Api.SetChecklistItemResolved(
+		m.SelectedTask.Id, selected.checklist.Id, selected.item.Id, !selected.item.Resolved,
+	)
+	if err != nil {
+		return common.ErrCmd(err)
+	}
+
+	return m.updateTask(task)
+}
+
+// addItem asks for an item to add to the checklist under the cursor or to
+// create a checklist first if the task has none.
+func (m *Model) addItem() tea.Cmd {
+	items := m.checklistItems()
+
+	var checklist clickup.Checklist
+	switch {
+	case m.itemCursor < len(items):
+		checklist = items[m.itemCursor].checklist
+	case len(m.SelectedTask.Checklists) > 0:
+		checklist = m.SelectedTask.Checklists[len(m.SelectedTask.Checklists)-1]
+	default:
+		return m.createChecklist()
+	}
+
+	taskId := m.SelectedTask.Id
+
+	return common.InputCmd(fmt.Sprintf("Add item to %q", checklist.Name), "", func(name string) tea.Cmd {
+		task, err := m.ctx.Api.AddChecklistItem(taskId, checklist.Id, name)
+		if err != nil {
+			return common.ErrCmd(err)
+		}
+
+		return m.updateTask(task)
+	})
+}
+
+func (m *Model) createChecklist() tea.Cmd {
+	taskId := m.SelectedTask.Id
+
+	return common.InputCmd("Checklist name", "", func(name string) tea.Cmd {
+		task, err := m.ctx.Api.CreateChecklist(taskId, name)
+		if err != nil {
+			return common.ErrCmd(err)
+		}
+
+		return m.updateTask(task)
+	})
+}
+
+// updateTask shows the task changed in the sidebar keeping the scroll
+// position. It is ignored if another task was selected in the meantime.
+func (m *Model) updateTask(task clickup.Task) tea.Cmd {
+	if task.Id != m.SelectedTask.Id {
+		return nil
+	}
+
+	m.SelectedTask = task
+	if err := m.render(false); err != nil {
+		return common.ErrCmd(err)
+	}
+
+	return nil
+}
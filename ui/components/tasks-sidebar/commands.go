@@ -0,0 +1,50 @@
+package taskssidebar
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prgrs/clickup/pkg/clickup"
+	"github.com/prgrs/clickup/pkg/termimg"
+)
+
+// OpenTaskMsg asks to show the task, e.g. one referenced by the shown task.
+type OpenTaskMsg string
+
+func OpenTaskCmd(taskId string) tea.Cmd {
+	return func() tea.Msg { return OpenTaskMsg(taskId) }
+}
+
+// AttachmentDownloadedMsg reports the file the attachment was saved to.
+type AttachmentDownloadedMsg struct {
+	Attachment clickup.Attachment
+	Path       string
+	Err        error
+}
+
+// AttachmentUploadedMsg returns the task the file was attached to.
+type AttachmentUploadedMsg struct {
+	Task clickup.Task
+	Path string
+	Err  error
+}
+
+// ImageLoadedMsg returns the image of the description ready to be shown
+// inline.
+type ImageLoadedMsg struct {
+	Url   string
+	Image termimg.Image
+	Err   error
+}
+
+// TaskNameLoadedMsg returns the name of a task referenced by the shown one.
+type TaskNameLoadedMsg struct {
+	TaskId string
+	Name   string
+	Err    error
+}
+
+// MembersLoadedMsg returns users of the workspace mentioned in descriptions.
+type MembersLoadedMsg struct {
+	TeamId string
+	Users  []clickup.User
+	Err    error
+}
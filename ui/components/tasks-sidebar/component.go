@@ -6,11 +6,12 @@ import (
 
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/log"
 	"github.com/mattn/go-runewidth"
+	"github.com/prgrs/clickup/api"
 	"github.com/prgrs/clickup/pkg/clickup"
+	"github.com/prgrs/clickup/pkg/termimg"
 	"github.com/prgrs/clickup/ui/common"
 	"github.com/prgrs/clickup/ui/context"
 )
@@ -29,6 +30,23 @@ type Model struct {
 	Ready        bool
 	ifBorders    bool
 	keyMap       KeyMap
+	// itemCursor points at a checklist item, a reference or an attachment of
+	// the selected task in order they are rendered.
+	itemCursor int
+	// history holds ids of tasks left by opening their references.
+	history []string
+	// attachmentStatus reports the last download or upload of an attachment.
+	attachmentStatus string
+	// images holds images of descriptions shown inline by their urls.
+	images        map[string]*termimg.Image
+	pendingImages []string
+	imageProtocol termimg.Protocol
+	// taskNames holds names of referenced tasks by their ids.
+	taskNames        map[string]string
+	pendingTaskNames []string
+	// members holds usernames by ids of workspaces and users.
+	members        map[string]map[string]string
+	pendingMembers []string
 }
 
 func (m Model) Id() common.Id {
@@ -51,7 +69,11 @@ func (m *Model) SetSize(s common.Size) {
 }
 
 func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
+	keyMap := DefaultKeyMap()
+	ctx.KeyBindings.Register(id, keyMap.Bindings(), id)
+
 	v := viewport.New(0, 0)
+	v.KeyMap = keyMap.KeyMap
 	v.Style = lipgloss.NewStyle().
 		Height(0)
 	v.SetContent("Loading...")
@@ -60,17 +82,21 @@ func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
 	log := common.NewLogger(logger, common.ResourceTypeRegistry.COMPONENT, id)
 
 	return Model{
-		id:           id,
-		ctx:          ctx,
-		viewport:     v,
-		Focused:      false,
-		Hidden:       false,
-		SelectedTask: clickup.Task{},
-		Ready:        false,
-		log:          log,
-		ifBorders:    true,
-		size:         size,
-		keyMap:       DefaultKeyMap(),
+		id:            id,
+		ctx:           ctx,
+		viewport:      v,
+		Focused:       false,
+		Hidden:        false,
+		SelectedTask:  clickup.Task{},
+		Ready:         false,
+		log:           log,
+		ifBorders:     true,
+		size:          size,
+		keyMap:        keyMap,
+		images:        map[string]*termimg.Image{},
+		imageProtocol: termimg.Detect(ctx.Config.Images.Protocol),
+		taskNames:     map[string]string{},
+		members:       map[string]map[string]string{},
 	}
 }
 
@@ -80,8 +106,30 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		cmds []tea.Cmd
 	)
 
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if cmd, ok := m.handleKeys(msg); ok {
+			return cmd
+		}
+
+	case AttachmentDownloadedMsg:
+		return m.handleAttachmentDownloaded(msg)
+
+	case AttachmentUploadedMsg:
+		return m.handleAttachmentUploaded(msg)
+
+	case ImageLoadedMsg:
+		return m.handleImageLoaded(msg)
+
+	case TaskNameLoadedMsg:
+		return m.handleTaskNameLoaded(msg)
+
+	case MembersLoadedMsg:
+		return m.handleMembersLoaded(msg)
+	}
+
 	m.viewport, cmd = m.viewport.Update(msg)
-	cmds = append(cmds, cmd)
+	cmds = append(cmds, cmd, m.loadImages(), m.loadTaskNames(), m.loadMembers())
 
 	return tea.Batch(cmds...)
 }
@@ -92,22 +140,21 @@ func (m Model) renderTask(task clickup.Task) (string, error) {
 	header := fmt.Sprintf("[#%s] %s\n", task.Id, task.Name)
 	s.WriteString(header)
 
+	if len(task.Tags) > 0 {
+		s.WriteString(common.TagChips(task.Tags) + "\n")
+	}
+
 	divider := strings.Repeat("-", runewidth.StringWidth(header))
 	s.WriteString(divider)
 
-	r, err := glamour.NewTermRenderer(
-		glamour.WithAutoStyle(),
-		glamour.WithWordWrap(m.viewport.Width),
-	)
-	if err != nil {
-		return "", err
-	}
-
-	out, err := r.Render(task.MarkdownDescription)
+	out, err := m.renderDescription()
 	if err != nil {
 		return "", err
 	}
 	s.WriteString(out)
+	s.WriteString(m.renderChecklists())
+	s.WriteString(m.renderReferences())
+	s.WriteString(m.renderAttachments())
 
 	return s.String(), nil
 }
@@ -163,6 +210,7 @@ func (m Model) WithHidden(h bool) Model {
 
 func (m *Model) SelectTask(id string) error {
 	m.Ready = false
+	m.ctx.Api.SetVisible(api.CacheNamespaceTasks, id)
 
 	task, err := m.ctx.Api.GetTask(id)
 	if err != nil {
@@ -177,15 +225,34 @@ func (m *Model) SelectTask(id string) error {
 	return nil
 }
 
+// SetTask shows the task. The scroll position and the checklist item cursor
+// are kept if the same task is shown again.
 func (m *Model) SetTask(task clickup.Task) error {
+	top := task.Id != m.SelectedTask.Id
+	if top {
+		m.itemCursor = 0
+		m.attachmentStatus = ""
+	}
+
 	m.SelectedTask = task
-	renderedTask, err := m.renderTask(task)
+	m.itemCursor = min(m.itemCursor, max(m.itemCount()-1, 0))
+
+	return m.render(top)
+}
+
+func (m *Model) render(top bool) error {
+	renderedTask, err := m.renderTask(m.SelectedTask)
 	if err != nil {
 		return err
 	}
 
 	m.viewport.SetContent(renderedTask)
-	_ = m.viewport.GotoTop()
+	if top {
+		_ = m.viewport.GotoTop()
+	}
+	m.queueImages()
+	m.queueTaskNames()
+	m.queueMembers()
 
 	return nil
 }
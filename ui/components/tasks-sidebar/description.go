@@ -0,0 +1,335 @@
+package taskssidebar
+
+import (
+	"hash/fnv"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/prgrs/clickup/pkg/termimg"
+	"github.com/prgrs/clickup/ui/common"
+)
+
+const (
+	// imageLabel starts the placeholder of an image, e.g. "[image: diagram.png]".
+	imageLabel = "[image: "
+	// maxImageRows limits the height of inline images in lines.
+	maxImageRows = 20
+)
+
+const taskUrl = `https?://app\.clickup\.com/t/[^\s)\]>]+`
+
+var (
+	// imageRe matches ![alt](url "title").
+	imageRe = regexp.MustCompile(`!\[([^\]]*)\]\((\S+?)(?:\s+"[^"]*")?\)`)
+	// mentionRe matches mentions of users, e.g. [@John Doe](#user_mention#123).
+	mentionRe = regexp.MustCompile(`\[@([^\]]*)\]\(#user_mention#(\d+)\)`)
+	// taskLinkRe matches links to tasks, e.g. [text](https://app.clickup.com/t/86abc).
+	taskLinkRe = regexp.MustCompile(`\[([^\]]*)\]\((` + taskUrl + `)\)`)
+	// taskUrlRe matches bare and autolinked urls of tasks.
+	taskUrlRe = regexp.MustCompile(`<` + taskUrl + `>|` + taskUrl)
+)
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`, "`", "\\`",
+)
+
+// renderDescription renders the markdown description of the selected task
+// with mentions of users and tasks resolved and images replaced by
+// placeholders followed by the images already loaded.
+func (m Model) renderDescription() (string, error) {
+	r, err := glamour.NewTermRenderer(
+		glamour.WithStandardStyle(m.ctx.Theme.MarkdownStyle),
+		glamour.WithWordWrap(m.viewport.Width),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := r.Render(m.preprocessDescription(m.SelectedTask.MarkdownDescription))
+	if err != nil {
+		return "", err
+	}
+
+	return m.insertImages(out), nil
+}
+
+// preprocessDescription rewrites constructs specific to ClickUp which glamour
+// would render as noise.
+func (m Model) preprocessDescription(md string) string {
+	md = imageRe.ReplaceAllStringFunc(md, func(s string) string {
+		match := imageRe.FindStringSubmatch(s)
+		return markdownEscaper.Replace(imageLabel + imageName(match[1], match[2]) + "]")
+	})
+
+	md = mentionRe.ReplaceAllStringFunc(md, func(s string) string {
+		match := mentionRe.FindStringSubmatch(s)
+		return "**@" + markdownEscaper.Replace(m.userName(match[2], match[1])) + "**"
+	})
+
+	md = taskLinkRe.ReplaceAllStringFunc(md, func(s string) string {
+		match := taskLinkRe.FindStringSubmatch(s)
+		return "**" + markdownEscaper.Replace(m.taskLinkTitle(parseTaskRef(match[2]), match[1])) + "**"
+	})
+
+	return taskUrlRe.ReplaceAllStringFunc(md, func(s string) string {
+		link, suffix := trimTaskUrl(s)
+		return "**" + markdownEscaper.Replace(m.taskLinkTitle(parseTaskRef(link), "")) + "**" + suffix
+	})
+}
+
+// imageName returns the alt text of the image or the name of its file.
+func imageName(alt string, rawUrl string) string {
+	if alt = strings.TrimSpace(alt); alt != "" {
+		return alt
+	}
+
+	if u, err := url.Parse(rawUrl); err == nil {
+		return path.Base(u.Path)
+	}
+
+	return rawUrl
+}
+
+// trimTaskUrl strips the angle brackets of an autolink and the punctuation
+// ending a sentence off the url. The punctuation is returned as the suffix.
+func trimTaskUrl(s string) (string, string) {
+	if strings.HasPrefix(s, "<") && strings.HasSuffix(s, ">") {
+		return s[1 : len(s)-1], ""
+	}
+
+	link := strings.TrimRight(s, ".,;:!?")
+
+	return link, s[len(link):]
+}
+
+// userName returns the username of the member of the task's workspace or the
+// fallback until the members are loaded or if the user is not found.
+func (m Model) userName(userId string, fallback string) string {
+	if name := m.members[m.SelectedTask.TeamId][userId]; name != "" {
+		return name
+	}
+
+	return fallback
+}
+
+// taskLinkTitle returns the id and the name of the linked task. The text of
+// the link stands in for the name until it is loaded or if the task cannot be
+// fetched.
+func (m Model) taskLinkTitle(taskId string, text string) string {
+	if name := m.taskName(taskId); name != "" {
+		return "[#" + taskId + "] " + name
+	}
+
+	if text = strings.TrimSpace(text); text != "" && !taskUrlRe.MatchString(text) {
+		return "[#" + taskId + "] " + text
+	}
+
+	return "[#" + taskId + "]"
+}
+
+// queueMembers schedules loading of members of the task's workspace if users
+// are mentioned in its description.
+func (m *Model) queueMembers() {
+	teamId := m.SelectedTask.TeamId
+	if teamId == "" || !mentionRe.MatchString(m.SelectedTask.MarkdownDescription) {
+		return
+	}
+
+	if _, ok := m.members[teamId]; !ok && !slices.Contains(m.pendingMembers, teamId) {
+		m.pendingMembers = append(m.pendingMembers, teamId)
+	}
+}
+
+// loadMembers fetches members of the queued workspaces in the background.
+func (m *Model) loadMembers() tea.Cmd {
+	cmds := []tea.Cmd{}
+	for _, teamId := range m.pendingMembers {
+		if _, ok := m.members[teamId]; ok {
+			continue
+		}
+
+		// No members mark the workspace being loaded or failed to be so it
+		// is not fetched again on every render.
+		m.members[teamId] = nil
+		cmds = append(cmds, m.loadMembersOf(teamId))
+	}
+	m.pendingMembers = nil
+
+	return tea.Batch(cmds...)
+}
+
+func (m Model) loadMembersOf(teamId string) tea.Cmd {
+	api := m.ctx.Api
+
+	return func() tea.Msg {
+		users, err := api.GetMembers(teamId)
+		return MembersLoadedMsg{TeamId: teamId, Users: users, Err: err}
+	}
+}
+
+func (m *Model) handleMembersLoaded(msg MembersLoadedMsg) tea.Cmd {
+	if msg.Err != nil {
+		m.log.Debug("Failed to get members", "teamId", msg.TeamId, "error", msg.Err)
+		return nil
+	}
+
+	names := make(map[string]string, len(msg.Users))
+	for _, u := range msg.Users {
+		names[strconv.Itoa(u.Id)] = u.Username
+	}
+
+	m.members[msg.TeamId] = names
+	if err := m.render(false); err != nil {
+		return common.ErrCmd(err)
+	}
+
+	return nil
+}
+
+// mentionedTaskIds returns ids of tasks linked in the description in order
+// of their first occurrence.
+func mentionedTaskIds(md string) []string {
+	md = imageRe.ReplaceAllString(md, "")
+
+	ids := []string{}
+	seen := map[string]bool{}
+	for _, s := range taskUrlRe.FindAllString(md, -1) {
+		link, _ := trimTaskUrl(s)
+		id := parseTaskRef(link)
+		if id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// imageUrls returns urls of images embedded in the description in order.
+func imageUrls(md string) []string {
+	urls := []string{}
+	for _, match := range imageRe.FindAllStringSubmatch(md, -1) {
+		urls = append(urls, match[2])
+	}
+
+	return urls
+}
+
+// insertImages adds the loaded images below the lines holding their
+// placeholders.
+func (m Model) insertImages(out string) string {
+	urls := imageUrls(m.SelectedTask.MarkdownDescription)
+	if len(urls) == 0 || m.imageProtocol == termimg.ProtocolNone {
+		return out
+	}
+
+	lines := []string{}
+	next := 0
+	for _, line := range strings.Split(out, "\n") {
+		lines = append(lines, line)
+
+		plain := ansi.Strip(line)
+		indent := strings.Repeat(" ", len(plain)-len(strings.TrimLeft(plain, " ")))
+
+		for n := strings.Count(plain, imageLabel); n > 0 && next < len(urls); n-- {
+			img := m.images[urls[next]]
+			next++
+			if img == nil {
+				continue
+			}
+
+			for _, l := range img.Lines() {
+				lines = append(lines, indent+l)
+			}
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// queueImages schedules loading of the images of the selected task which
+// have not been loaded yet.
+func (m *Model) queueImages() {
+	if m.imageProtocol == termimg.ProtocolNone {
+		return
+	}
+
+	for _, u := range imageUrls(m.SelectedTask.MarkdownDescription) {
+		if _, ok := m.images[u]; !ok && !slices.Contains(m.pendingImages, u) {
+			m.pendingImages = append(m.pendingImages, u)
+		}
+	}
+}
+
+// loadImages fetches the queued images in the background.
+func (m *Model) loadImages() tea.Cmd {
+	// Wait until the sidebar has room for the images.
+	maxCols := m.viewport.Width - 4
+	if len(m.pendingImages) == 0 || maxCols < 1 {
+		return nil
+	}
+
+	cmds := []tea.Cmd{}
+	for _, u := range m.pendingImages {
+		if _, ok := m.images[u]; ok {
+			continue
+		}
+
+		// A nil image marks the one being loaded or failed to be.
+		m.images[u] = nil
+		cmds = append(cmds, m.loadImage(u, maxCols))
+	}
+	m.pendingImages = nil
+
+	return tea.Batch(cmds...)
+}
+
+func (m Model) loadImage(rawUrl string, maxCols int) tea.Cmd {
+	api, output, protocol := m.ctx.Api, m.ctx.Output, m.imageProtocol
+
+	return func() tea.Msg {
+		img, err := api.GetImage(rawUrl)
+		if err != nil {
+			return ImageLoadedMsg{Url: rawUrl, Err: err}
+		}
+
+		h := fnv.New32a()
+		h.Write([]byte(rawUrl))
+
+		i, err := termimg.NewImage(protocol, h.Sum32(), img, maxCols, maxImageRows)
+		if err != nil {
+			return ImageLoadedMsg{Url: rawUrl, Err: err}
+		}
+
+		// A kitty image is sent once, the placeholders rendered in the
+		// sidebar refer to it by its id. It goes through the output of the
+		// program so it is not written in the middle of a frame. Sixel
+		// images are drawn by the rendered lines instead.
+		if err := i.Transmit(output); err != nil {
+			return ImageLoadedMsg{Url: rawUrl, Err: err}
+		}
+
+		return ImageLoadedMsg{Url: rawUrl, Image: i}
+	}
+}
+
+func (m *Model) handleImageLoaded(msg ImageLoadedMsg) tea.Cmd {
+	if msg.Err != nil {
+		m.log.Debug("Failed to load image", "url", msg.Url, "error", msg.Err)
+		return nil
+	}
+
+	m.images[msg.Url] = &msg.Image
+	if err := m.render(false); err != nil {
+		return common.ErrCmd(err)
+	}
+
+	return nil
+}
@@ -24,6 +24,25 @@ func (m Model) Help() help.KeyMap {
 					km.HalfPageUp,
 					km.HalfPageDown,
 				},
+				{
+					km.NextItem,
+					km.PrevItem,
+					km.ToggleItem,
+					km.AddItem,
+					km.CreateChecklist,
+				},
+				{
+					km.OpenReference,
+					km.Back,
+					km.AddWaitingOn,
+					km.AddBlocking,
+					km.AddLink,
+					km.RemoveReference,
+				},
+				{
+					km.DownloadAttachment,
+					km.UploadAttachment,
+				},
 			}
 		},
 		func() []key.Binding {
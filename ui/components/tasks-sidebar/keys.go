@@ -1,13 +1,199 @@
 package taskssidebar
 
-import "github.com/charmbracelet/bubbles/viewport"
+import (
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prgrs/clickup/api"
+	"github.com/prgrs/clickup/ui/keybindings"
+)
 
 type KeyMap struct {
 	viewport.KeyMap
+	NextItem           key.Binding
+	PrevItem           key.Binding
+	ToggleItem         key.Binding
+	AddItem            key.Binding
+	CreateChecklist    key.Binding
+	OpenReference      key.Binding
+	Back               key.Binding
+	AddWaitingOn       key.Binding
+	AddBlocking        key.Binding
+	AddLink            key.Binding
+	RemoveReference    key.Binding
+	DownloadAttachment key.Binding
+	UploadAttachment   key.Binding
 }
 
 func DefaultKeyMap() KeyMap {
+	km := viewport.DefaultKeyMap()
+
+	// "u" and "d" are taken by the tasks widget
+	km.HalfPageUp = key.NewBinding(
+		key.WithKeys("ctrl+u"),
+		key.WithHelp("ctrl+u", "½ page up"),
+	)
+	km.HalfPageDown = key.NewBinding(
+		key.WithKeys("ctrl+d"),
+		key.WithHelp("ctrl+d", "½ page down"),
+	)
+
 	return KeyMap{
-		KeyMap: viewport.DefaultKeyMap(),
+		KeyMap: km,
+		NextItem: key.NewBinding(
+			key.WithKeys("tab"),
+			key.WithHelp("tab", "next item"),
+		),
+		PrevItem: key.NewBinding(
+			key.WithKeys("shift+tab"),
+			key.WithHelp("shift+tab", "previous item"),
+		),
+		ToggleItem: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp("x", "tick checklist item"),
+		),
+		AddItem: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", "add checklist item"),
+		),
+		CreateChecklist: key.NewBinding(
+			key.WithKeys("C"),
+			key.WithHelp("C", "create checklist"),
+		),
+		OpenReference: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "open referenced task"),
+		),
+		Back: key.NewBinding(
+			key.WithKeys("backspace"),
+			key.WithHelp("backspace", "back to previous task"),
+		),
+		AddWaitingOn: key.NewBinding(
+			key.WithKeys("W"),
+			key.WithHelp("W", "add waiting on"),
+		),
+		AddBlocking: key.NewBinding(
+			key.WithKeys("B"),
+			key.WithHelp("B", "add blocking"),
+		),
+		AddLink: key.NewBinding(
+			key.WithKeys("L"),
+			key.WithHelp("L", "link task"),
+		),
+		RemoveReference: key.NewBinding(
+			key.WithKeys("D"),
+			key.WithHelp("D", "remove dependency or link"),
+		),
+		DownloadAttachment: key.NewBinding(
+			key.WithKeys("s"),
+			key.WithHelp("s", "download attachment"),
+		),
+		UploadAttachment: key.NewBinding(
+			key.WithKeys("a"),
+			key.WithHelp("a", "upload attachment"),
+		),
+	}
+}
+
+func (km *KeyMap) Bindings() keybindings.Bindings {
+	return keybindings.Bindings{
+		"page_down":           &km.PageDown,
+		"page_up":             &km.PageUp,
+		"half_page_up":        &km.HalfPageUp,
+		"half_page_down":      &km.HalfPageDown,
+		"up":                  &km.Up,
+		"down":                &km.Down,
+		"next_item":           &km.NextItem,
+		"prev_item":           &km.PrevItem,
+		"toggle_item":         &km.ToggleItem,
+		"add_item":            &km.AddItem,
+		"create_checklist":    &km.CreateChecklist,
+		"open_reference":      &km.OpenReference,
+		"back":                &km.Back,
+		"add_waiting_on":      &km.AddWaitingOn,
+		"add_blocking":        &km.AddBlocking,
+		"add_link":            &km.AddLink,
+		"remove_reference":    &km.RemoveReference,
+		"download_attachment": &km.DownloadAttachment,
+		"upload_attachment":   &km.UploadAttachment,
 	}
 }
+
+// handleKeys handles keys bound to actions of the sidebar and reports if the
+// key was one of them.
+func (m *Model) handleKeys(msg tea.KeyMsg) (tea.Cmd, bool) {
+	if action, ok := keybindings.Match(msg, m.keyMap.Bindings()); ok {
+		return m.handleAction(action), true
+	}
+
+	return nil, false
+}
+
+// Handlers returns the handler of the actions of the sidebar.
+func (m *Model) Handlers() keybindings.Handlers {
+	return keybindings.Handlers{id: m.handleAction}
+}
+
+// handleAction runs the action of the sidebar by its name.
+func (m *Model) handleAction(action string) tea.Cmd {
+	switch action {
+	case "page_down":
+		m.viewport.ViewDown()
+
+	case "page_up":
+		m.viewport.ViewUp()
+
+	case "half_page_down":
+		m.viewport.HalfViewDown()
+
+	case "half_page_up":
+		m.viewport.HalfViewUp()
+
+	case "down":
+		m.viewport.LineDown(1)
+
+	case "up":
+		m.viewport.LineUp(1)
+
+	case "next_item":
+		return m.moveItemCursor(1)
+
+	case "prev_item":
+		return m.moveItemCursor(-1)
+
+	case "toggle_item":
+		return m.toggleItem()
+
+	case "add_item":
+		return m.addItem()
+
+	case "create_checklist":
+		return m.createChecklist()
+
+	case "open_reference":
+		return m.openReference()
+
+	case "back":
+		return m.back()
+
+	case "add_waiting_on":
+		return m.addRelation(api.RelationWaitingOn)
+
+	case "add_blocking":
+		return m.addRelation(api.RelationBlocking)
+
+	case "add_link":
+		return m.addRelation(api.RelationLinked)
+
+	case "remove_reference":
+		return m.removeReference()
+
+	case "download_attachment":
+		return m.downloadAttachment()
+
+	case "upload_attachment":
+		return m.uploadAttachment()
+	}
+
+	return nil
+}
@@ -0,0 +1,283 @@
+package taskssidebar
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prgrs/clickup/api"
+	"github.com/prgrs/clickup/ui/common"
+)
+
+// reference is a task related to the selected one or linked in its
+// description.
+type reference struct {
+	relation  api.Relation
+	taskId    string
+	mentioned bool
+}
+
+const mentionedSection = "Mentioned in description"
+
+// section returns the title of the section the reference is rendered in.
+func (r reference) section() string {
+	if r.mentioned {
+		return mentionedSection
+	}
+
+	for _, section := range referenceSections {
+		if section.relation == r.relation {
+			return section.title
+		}
+	}
+
+	return ""
+}
+
+var referenceSections = []struct {
+	relation api.Relation
+	title    string
+}{
+	{api.RelationWaitingOn, "Waiting on"},
+	{api.RelationBlocking, "Blocking"},
+	{api.RelationLinked, "Linked tasks"},
+}
+
+// references returns tasks related to the selected task followed by the
+// other tasks linked in its description in order they are rendered.
+func (m Model) references() []reference {
+	task := m.SelectedTask
+	ids := map[api.Relation][]string{
+		api.RelationWaitingOn: task.WaitingOn(),
+		api.RelationBlocking:  task.Blocking(),
+		api.RelationLinked:    task.Linked(),
+	}
+
+	refs := []reference{}
+	seen := map[string]bool{task.Id: true}
+	for _, section := range referenceSections {
+		for _, id := range ids[section.relation] {
+			refs = append(refs, reference{relation: section.relation, taskId: id})
+			seen[id] = true
+		}
+	}
+
+	for _, id := range mentionedTaskIds(task.MarkdownDescription) {
+		if !seen[id] {
+			refs = append(refs, reference{taskId: id, mentioned: true})
+			seen[id] = true
+		}
+	}
+
+	return refs
+}
+
+// itemCount returns the number of rows the item cursor can point at.
+func (m Model) itemCount() int {
+	return len(m.checklistItems()) + len(m.references()) + len(m.attachments())
+}
+
+func (m Model) selectedReference() (reference, bool) {
+	i := m.itemCursor - len(m.checklistItems())
+	refs := m.references()
+	if i < 0 || i >= len(refs) {
+		return reference{}, false
+	}
+
+	return refs[i], true
+}
+
+func (m Model) renderReferences() string {
+	refs := m.references()
+	if len(refs) == 0 {
+		return ""
+	}
+
+	s := strings.Builder{}
+	offset := len(m.checklistItems())
+
+	section := ""
+	for i, ref := range refs {
+		if ref.section() != section {
+			section = ref.section()
+			fmt.Fprintf(&s, "\n%s\n", section)
+		}
+
+		line := "  -> " + m.referenceTitle(ref.taskId)
+		if offset+i == m.itemCursor {
+			line = m.ctx.Style.TableHighlight.Render(line)
+		}
+
+		s.WriteString(line + "\n")
+	}
+
+	return s.String()
+}
+
+// referenceTitle returns the id and the name of the task. The name is left
+// out until it is loaded or if the task cannot be fetched, e.g. it is in a
+// private list.
+func (m Model) referenceTitle(taskId string) string {
+	if name := m.taskName(taskId); name != "" {
+		return fmt.Sprintf("[#%s] %s", taskId, name)
+	}
+
+	return "[#" + taskId + "]"
+}
+
+// taskName returns the name of the task or an empty string if it is not
+// loaded.
+func (m Model) taskName(taskId string) string {
+	if taskId == m.SelectedTask.Id {
+		return m.SelectedTask.Name
+	}
+
+	return m.taskNames[taskId]
+}
+
+// queueTaskNames schedules loading of names of the referenced tasks which
+// have not been loaded yet.
+func (m *Model) queueTaskNames() {
+	for _, ref := range m.references() {
+		if _, ok := m.taskNames[ref.taskId]; !ok && !slices.Contains(m.pendingTaskNames, ref.taskId) {
+			m.pendingTaskNames = append(m.pendingTaskNames, ref.taskId)
+		}
+	}
+}
+
+// loadTaskNames fetches the queued names in the background.
+func (m *Model) loadTaskNames() tea.Cmd {
+	cmds := []tea.Cmd{}
+	for _, id := range m.pendingTaskNames {
+		if _, ok := m.taskNames[id]; ok {
+			continue
+		}
+
+		// An empty name marks the task being loaded or failed to be so it
+		// is not fetched again on every render.
+		m.taskNames[id] = ""
+		cmds = append(cmds, m.loadTaskName(id))
+	}
+	m.pendingTaskNames = nil
+
+	return tea.Batch(cmds...)
+}
+
+func (m Model) loadTaskName(taskId string) tea.Cmd {
+	api := m.ctx.Api
+
+	return func() tea.Msg {
+		task, err := api.GetTask(taskId)
+		if err == nil && task.Id == "" {
+			err = fmt.Errorf("task %s not found", taskId)
+		}
+
+		return TaskNameLoadedMsg{TaskId: taskId, Name: task.Name, Err: err}
+	}
+}
+
+func (m *Model) handleTaskNameLoaded(msg TaskNameLoadedMsg) tea.Cmd {
+	if msg.Err != nil {
+		m.log.Debug("Failed to get referenced task", "id", msg.TaskId, "error", msg.Err)
+		return nil
+	}
+
+	m.taskNames[msg.TaskId] = msg.Name
+	if err := m.render(false); err != nil {
+		return common.ErrCmd(err)
+	}
+
+	return nil
+}
+
+// openReference shows the task under the cursor. The shown task can be
+// returned to with back.
+func (m *Model) openReference() tea.Cmd {
+	ref, ok := m.selectedReference()
+	if !ok {
+		return nil
+	}
+
+	m.log.Info("Opening referenced task", "id", ref.taskId, "section", ref.section())
+	m.history = append(m.history, m.SelectedTask.Id)
+
+	return OpenTaskCmd(ref.taskId)
+}
+
+func (m *Model) back() tea.Cmd {
+	if len(m.history) == 0 {
+		return nil
+	}
+
+	id := m.history[len(m.history)-1]
+	m.history = m.history[:len(m.history)-1]
+
+	return OpenTaskCmd(id)
+}
+
+func (m *Model) addRelation(relation api.Relation) tea.Cmd {
+	task := m.SelectedTask
+	if task.Id == "" {
+		return nil
+	}
+
+	var title string
+	switch relation {
+	case api.RelationWaitingOn:
+		title = fmt.Sprintf("Task %q is waiting on (id or url)", task.Name)
+	case api.RelationBlocking:
+		title = fmt.Sprintf("Task %q is blocking (id or url)", task.Name)
+	default:
+		title = fmt.Sprintf("Link task %q with (id or url)", task.Name)
+	}
+
+	return common.InputCmd(title, "", func(value string) tea.Cmd {
+		otherId := parseTaskRef(value)
+		if otherId == "" {
+			return nil
+		}
+
+		t, err := m.ctx.Api.AddRelation(task.Id, otherId, relation)
+		if err != nil {
+			return common.ErrCmd(err)
+		}
+
+		return m.updateTask(t)
+	})
+}
+
+// removeReference removes the relation under the cursor. Tasks mentioned in
+// the description are left to be removed by editing it.
+func (m *Model) removeReference() tea.Cmd {
+	ref, ok := m.selectedReference()
+	if !ok || ref.mentioned {
+		return nil
+	}
+
+	taskId := m.SelectedTask.Id
+	title := fmt.Sprintf("Remove %s %s?", ref.relation, m.referenceTitle(ref.taskId))
+
+	return common.ConfirmCmd(title, func() tea.Cmd {
+		t, err := m.ctx.Api.RemoveRelation(taskId, ref.taskId, ref.relation)
+		if err != nil {
+			return common.ErrCmd(err)
+		}
+
+		return m.updateTask(t)
+	})
+}
+
+// parseTaskRef returns the task id given directly or as a task url, e.g.
+// https://app.clickup.com/t/86abc123.
+func parseTaskRef(value string) string {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "#")
+
+	if u, err := url.Parse(value); err == nil && u.Host != "" {
+		return path.Base(strings.TrimSuffix(u.Path, "/"))
+	}
+
+	return value
+}
@@ -28,6 +28,7 @@ type Model struct {
 	Hidden    bool
 	ifBorders bool
 	Path      string
+	Title     string
 	StartIdx  int
 	EndIdx    int
 
@@ -46,14 +47,18 @@ func (m *Model) SetSize(s common.Size) {
 func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
 	log := common.NewLogger(logger, common.ResourceTypeRegistry.COMPONENT, id)
 
+	keyMap := DefaultKeyMap()
+	ctx.KeyBindings.Register(id, keyMap.Bindings(), id)
+
 	return Model{
 		id:          id,
 		ctx:         ctx,
 		tabs:        []Tab{},
 		log:         log,
-		keyMap:      DefaultKeyMap(),
+		keyMap:      keyMap,
 		ifBorders:   true,
 		Path:        "",
+		Title:       "Views",
 		StartIdx:    0,
 		EndIdx:      0,
 		SelectedIdx: 0,
@@ -93,7 +98,7 @@ func (m Model) View() string {
 	moreTabsIcon := "+"
 	tabSeperatorIcon := "|"
 	suffix := ""
-	prefix := " Views "
+	prefix := " " + m.Title + " "
 	if len(m.tabs) == 0 {
 		prefix += tabSeperatorIcon + " "
 	}
@@ -143,9 +148,9 @@ func (m Model) View() string {
 	var s []string
 
 	for i, tab := range m.tabs {
-		style := inactiveTabStyle
+		style := m.ctx.Style.TabInactive
 		if i == selectedIdx {
-			style = activeTabStyle
+			style = m.ctx.Style.TabActive
 		}
 		content := style.Render(" " + tab.Name + " ")
 
@@ -177,6 +182,11 @@ func (m Model) View() string {
 	)
 }
 
+func (m Model) WithTitle(title string) Model {
+	m.Title = title
+	return m
+}
+
 func (m Model) Init() tea.Cmd {
 	m.log.Info("Initializing...")
 	return nil
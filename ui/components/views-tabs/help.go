@@ -28,3 +28,7 @@ func (m Model) Help() help.KeyMap {
 		},
 	)
 }
+
+func (m Model) Modes() []string {
+	return []string{string(m.id)}
+}
@@ -3,6 +3,7 @@ package viewstabs
 import (
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prgrs/clickup/ui/keybindings"
 )
 
 type KeyMap struct {
@@ -20,7 +21,7 @@ func DefaultKeyMap() KeyMap {
 			key.WithHelp("h, left", "previous tab"),
 		),
 		CursorLeftAndSelect: key.NewBinding(
-			key.WithKeys("H", "left"),
+			key.WithKeys("H", "shift+left"),
 			key.WithHelp("H, shift+left", "select tab"),
 		),
 		CursorRight: key.NewBinding(
@@ -38,9 +39,33 @@ func DefaultKeyMap() KeyMap {
 	}
 }
 
+func (km *KeyMap) Bindings() keybindings.Bindings {
+	return keybindings.Bindings{
+		"cursor_left":             &km.CursorLeft,
+		"cursor_left_and_select":  &km.CursorLeftAndSelect,
+		"cursor_right":            &km.CursorRight,
+		"cursor_right_and_select": &km.CursorRightAndSelect,
+		"select":                  &km.Select,
+	}
+}
+
 func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
-	switch {
-	case key.Matches(msg, m.keyMap.CursorLeft):
+	if action, ok := keybindings.Match(msg, m.keyMap.Bindings()); ok {
+		return m.handleAction(action)
+	}
+
+	return nil
+}
+
+// Handlers returns the handler of the actions of the tabs.
+func (m *Model) Handlers() keybindings.Handlers {
+	return keybindings.Handlers{id: m.handleAction}
+}
+
+// handleAction runs the action of the tabs by its name.
+func (m *Model) handleAction(action string) tea.Cmd {
+	switch action {
+	case "cursor_left":
 		index := prevTab(m.tabs, m.SelectedIdx)
 		if m.SelectedIdx == index {
 			break
@@ -49,7 +74,7 @@ func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
 		m.Selected = m.tabs[index].Id
 		return nil
 
-	case key.Matches(msg, m.keyMap.CursorRight):
+	case "cursor_right":
 		index := nextTab(m.tabs, m.SelectedIdx)
 		if m.SelectedIdx == index {
 			break
@@ -58,11 +83,11 @@ func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
 		m.Selected = m.tabs[index].Id
 		return nil
 
-	case key.Matches(msg, m.keyMap.Select):
+	case "select":
 		m.Selected = m.tabs[m.SelectedIdx].Id
 		return TabChangedCmd(m.Selected)
 
-	case key.Matches(msg, m.keyMap.CursorLeftAndSelect):
+	case "cursor_left_and_select":
 		index := prevTab(m.tabs, m.SelectedIdx)
 		if m.SelectedIdx == index {
 			break
@@ -71,7 +96,7 @@ func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
 		m.Selected = m.tabs[index].Id
 		return TabChangedCmd(m.Selected)
 
-	case key.Matches(msg, m.keyMap.CursorRightAndSelect):
+	case "cursor_right_and_select":
 		index := nextTab(m.tabs, m.SelectedIdx)
 		if m.SelectedIdx == index {
 			break
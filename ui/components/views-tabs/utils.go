@@ -1,16 +1,9 @@
 package viewstabs
 
 import (
-	"github.com/charmbracelet/lipgloss"
 	"github.com/prgrs/clickup/pkg/clickup"
 )
 
-var (
-	highlightColor   = lipgloss.AdaptiveColor{Light: "#874BFD", Dark: "#7D56F4"}
-	activeTabStyle   = lipgloss.NewStyle().Background(highlightColor)
-	inactiveTabStyle = lipgloss.NewStyle().Background(lipgloss.Color("0"))
-)
-
 //nolint:unused
 func removeView(views []clickup.View, s int) []clickup.View {
 	return append(views[:s], views[s+1:]...)
@@ -43,18 +43,25 @@ func (m Model) WithFocused(f bool) Model {
 }
 
 func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
+	delegate := list.NewDefaultDelegate()
+	delegate.Styles = ctx.Style.ListItems
+
 	l := list.New(
 		[]list.Item{},
-		list.NewDefaultDelegate(),
+		delegate,
 		0, 0,
 	)
 
 	l.KeyMap.Quit.Unbind()
+	l.Styles.Title = ctx.Style.ListTitle
 	l.SetShowHelp(false)
 	l.Title = "Workspaces"
 
 	log := common.NewLogger(logger, common.ResourceTypeRegistry.COMPONENT, id)
 
+	keyMap := DefaultKeyMap()
+	ctx.KeyBindings.Register(id, keyMap.Bindings(), id)
+
 	return Model{
 		id:         id,
 		list:       l,
@@ -64,7 +71,7 @@ func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
 		log:        log,
 		ifBorders:  true,
 		Focused:    false,
-		keyMap:     DefaultKeyMap(),
+		keyMap:     keyMap,
 	}
 }
 
@@ -28,5 +28,5 @@ func (m Model) Help() help.KeyMap {
 				m.keyMap.Select,
 			)
 		},
-	).With(common.KeyBindingBack)
+	)
 }
@@ -5,6 +5,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/prgrs/clickup/pkg/clickup"
 	listitem "github.com/prgrs/clickup/ui/components/list-item"
+	"github.com/prgrs/clickup/ui/keybindings"
 )
 
 type KeyMap struct {
@@ -31,7 +32,7 @@ func DefaultKeyMap() KeyMap {
 		),
 		CursorDownAndSelect: key.NewBinding(
 			key.WithKeys("J", "shift+down"),
-			key.WithHelp("J, down", "down and select"),
+			key.WithHelp("J, shift+down", "down and select"),
 		),
 		Select: key.NewBinding(
 			key.WithKeys("enter"),
@@ -40,9 +41,33 @@ func DefaultKeyMap() KeyMap {
 	}
 }
 
+func (km *KeyMap) Bindings() keybindings.Bindings {
+	return keybindings.Bindings{
+		"cursor_up":              &km.CursorUp,
+		"cursor_up_and_select":   &km.CursorUpAndSelect,
+		"cursor_down":            &km.CursorDown,
+		"cursor_down_and_select": &km.CursorDownAndSelect,
+		"select":                 &km.Select,
+	}
+}
+
 func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
-	switch {
-	case key.Matches(msg, m.keyMap.Select):
+	if action, ok := keybindings.Match(msg, m.keyMap.Bindings()); ok {
+		return m.handleAction(action)
+	}
+
+	return nil
+}
+
+// Handlers returns the handler of the actions of the list.
+func (m *Model) Handlers() keybindings.Handlers {
+	return keybindings.Handlers{id: m.handleAction}
+}
+
+// handleAction runs the action of the list by its name.
+func (m *Model) handleAction(action string) tea.Cmd {
+	switch action {
+	case "select":
 		if m.list.SelectedItem() == nil {
 			m.log.Info("List is empty")
 			break
@@ -55,10 +80,10 @@ func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
 		m.Selected = selected
 		return WorkspaceChangedCmd(selected.Id)
 
-	case key.Matches(msg, m.keyMap.CursorDown):
+	case "cursor_down":
 		m.list.CursorDown()
 
-	case key.Matches(msg, m.keyMap.CursorDownAndSelect):
+	case "cursor_down_and_select":
 		m.list.CursorDown()
 		if m.list.SelectedItem() == nil {
 			m.log.Info("List is empty")
@@ -72,10 +97,10 @@ func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
 		m.Selected = selected
 		return WorkspacePreviewCmd(selected.Id)
 
-	case key.Matches(msg, m.keyMap.CursorUp):
+	case "cursor_up":
 		m.list.CursorUp()
 
-	case key.Matches(msg, m.keyMap.CursorUpAndSelect):
+	case "cursor_up_and_select":
 		m.list.CursorUp()
 		if m.list.SelectedItem() == nil {
 			m.log.Info("List is empty")
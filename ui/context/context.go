@@ -1,18 +1,28 @@
 package context
 
 import (
+	"io"
+	"os"
+
 	"github.com/charmbracelet/log"
 	"github.com/prgrs/clickup/api"
 	"github.com/prgrs/clickup/internal/config"
+	"github.com/prgrs/clickup/ui/keybindings"
 	"github.com/prgrs/clickup/ui/theme"
 )
 
 type UserContext struct {
-	Api        *api.Api
-	Config     *config.Config
-	Style      *theme.Style
-	Theme      *theme.Theme
-	WindowSize WindowSize
+	Api         *api.Api
+	Config      *config.Config
+	KeyBindings *keybindings.KeyBindings
+	Style       *theme.Style
+	Theme       *theme.Theme
+	WindowSize  WindowSize
+	// TaskEvents is nil unless the webhook receiver is enabled.
+	TaskEvents <-chan api.TaskEvent
+	// Output is the terminal the program renders to. Anything written to the
+	// terminal besides the view, e.g. images, goes through it.
+	Output io.Writer
 }
 
 type WindowSize struct {
@@ -26,16 +36,18 @@ func (w *WindowSize) Set(width, height int) {
 	w.Height = height
 }
 
-func NewUserContext(logger *log.Logger, api *api.Api, config *config.Config) UserContext {
+func NewUserContext(logger *log.Logger, api *api.Api, config *config.Config, keyBindings *keybindings.KeyBindings, t *theme.Theme) UserContext {
 	return UserContext{
-		Style: theme.DefautlStyle,
-		Theme: theme.DefaultTheme,
+		Style: theme.NewStyle(t),
+		Theme: t,
 		WindowSize: WindowSize{
 			Width:      0,
 			Height:     0,
 			MetaHeight: 0,
 		},
-		Api:    api,
-		Config: config,
+		Api:         api,
+		Config:      config,
+		KeyBindings: keyBindings,
+		Output:      os.Stdout,
 	}
 }
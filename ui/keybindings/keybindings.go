@@ -0,0 +1,245 @@
+package keybindings
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prgrs/clickup/internal/config"
+)
+
+// ModeGlobal is a mode which bindings are active in every other mode.
+const ModeGlobal = "global"
+
+var (
+	ErrUnknownPreset    = fmt.Errorf("unknown keybindings preset")
+	ErrUnknownComponent = fmt.Errorf("unknown keybindings component")
+	ErrUnknownAction    = fmt.Errorf("unknown keybindings action")
+	ErrConflict         = fmt.Errorf("conflicting keybindings")
+)
+
+// Bindings maps action names of a component to its key bindings.
+type Bindings map[string]*key.Binding
+
+type Overrides map[string]map[string][]string
+
+// Action is a binding registered by a component under a name.
+type Action struct {
+	Component string
+	Name      string
+	Binding   key.Binding
+}
+
+func (a Action) Id() string {
+	return a.Component + "." + a.Name
+}
+
+func (a Action) Title() string {
+	if desc := a.Binding.Help().Desc; desc != "" {
+		return desc
+	}
+
+	return strings.ReplaceAll(a.Name, "_", " ")
+}
+
+// Handler runs an action of a component by its name, the same way as when
+// its key is pressed.
+type Handler func(action string) tea.Cmd
+
+// Handlers maps components to the handlers of their actions.
+type Handlers map[string]Handler
+
+// Merge adds the handlers of other components.
+func (h Handlers) Merge(others ...Handlers) Handlers {
+	for _, other := range others {
+		for component, handler := range other {
+			h[component] = handler
+		}
+	}
+
+	return h
+}
+
+// Match returns the name of the enabled action the key is bound to.
+func Match(msg tea.KeyMsg, b Bindings) (string, bool) {
+	for _, action := range sortedKeys(b) {
+		if key.Matches(msg, *b[action]) {
+			return action, true
+		}
+	}
+
+	return "", false
+}
+
+// KeyBindings is the registry of the actions of all components.
+type KeyBindings struct {
+	overrides Overrides
+	known     map[string]map[string]bool
+	modes     map[string][]Action
+}
+
+func New(cfg config.Keybindings) (*KeyBindings, error) {
+	overrides := Overrides{}
+
+	if cfg.Preset != "" && cfg.Preset != PresetDefault {
+		preset, ok := presets[cfg.Preset]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownPreset, cfg.Preset)
+		}
+		overrides.merge(preset)
+	}
+
+	overrides.merge(cfg.Components)
+
+	return &KeyBindings{
+		overrides: overrides,
+		known:     map[string]map[string]bool{},
+		modes:     map[string][]Action{},
+	}, nil
+}
+
+func (o Overrides) merge(src Overrides) {
+	for component, actions := range src {
+		if _, ok := o[component]; !ok {
+			o[component] = map[string][]string{}
+		}
+
+		for action, keys := range actions {
+			o[component][action] = keys
+		}
+	}
+}
+
+// Register applies configured overrides to the bindings of a component and
+// records them under the given modes so they can be validated for conflicts.
+func (k *KeyBindings) Register(component string, b Bindings, modes ...string) {
+	if _, ok := k.known[component]; !ok {
+		k.known[component] = map[string]bool{}
+	}
+
+	for action, kb := range b {
+		k.known[component][action] = true
+
+		if keys, ok := k.overrides[component][action]; ok {
+			override(kb, keys)
+		}
+
+		for _, mode := range modes {
+			k.modes[mode] = append(k.modes[mode], Action{
+				Component: component,
+				Name:      action,
+				Binding:   *kb,
+			})
+		}
+	}
+}
+
+// Validate reports overrides of unknown components or actions and keys bound to
+// more than one action within the same mode.
+func (k *KeyBindings) Validate() error {
+	for _, component := range sortedKeys(k.overrides) {
+		actions, ok := k.known[component]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownComponent, component)
+		}
+
+		for _, action := range sortedKeys(k.overrides[component]) {
+			if !actions[action] {
+				return fmt.Errorf("%w: %s.%s", ErrUnknownAction, component, action)
+			}
+		}
+	}
+
+	for _, mode := range sortedKeys(k.modes) {
+		if mode == ModeGlobal {
+			continue
+		}
+
+		if err := validateMode(mode, append(k.modes[mode], k.modes[ModeGlobal]...)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Actions returns enabled actions registered under the given modes and the
+// global mode, which components have a handler, sorted by their title.
+func (k *KeyBindings) Actions(h Handlers, modes ...string) []Action {
+	seen := map[string]bool{}
+	actions := []Action{}
+
+	for _, mode := range append(modes, ModeGlobal) {
+		for _, a := range k.modes[mode] {
+			if seen[a.Id()] || !a.Binding.Enabled() || h[a.Component] == nil {
+				continue
+			}
+			seen[a.Id()] = true
+			actions = append(actions, a)
+		}
+	}
+
+	sort.Slice(actions, func(i, j int) bool {
+		return actions[i].Title() < actions[j].Title()
+	})
+
+	return actions
+}
+
+func validateMode(mode string, actions []Action) error {
+	taken := map[string]Action{}
+
+	for _, a := range actions {
+		for _, kk := range a.Binding.Keys() {
+			other, ok := taken[kk]
+			if !ok {
+				taken[kk] = a
+				continue
+			}
+
+			if other.Id() == a.Id() {
+				continue
+			}
+
+			return fmt.Errorf("%w in mode %s: %q is bound to both %s and %s",
+				ErrConflict, mode, kk, other.Id(), a.Id())
+		}
+	}
+
+	return nil
+}
+
+func override(kb *key.Binding, keys []string) {
+	if len(keys) == 0 {
+		kb.Unbind()
+		return
+	}
+
+	kb.SetEnabled(true)
+	kb.SetKeys(keys...)
+	kb.SetHelp(helpKeys(keys), kb.Help().Desc)
+}
+
+func helpKeys(keys []string) string {
+	h := make([]string, len(keys))
+	for i, k := range keys {
+		if k == " " {
+			k = "space"
+		}
+		h[i] = k
+	}
+
+	return strings.Join(h, ", ")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
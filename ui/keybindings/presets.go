@@ -0,0 +1,98 @@
+package keybindings
+
+const (
+	PresetDefault = "default"
+	PresetVim     = "vim"
+	PresetEmacs   = "emacs"
+)
+
+var listsVim = map[string][]string{
+	"cursor_up":              {"k", "up"},
+	"cursor_up_and_select":   {"K", "shift+up"},
+	"cursor_down":            {"j", "down"},
+	"cursor_down_and_select": {"J", "shift+down"},
+	"select":                 {"enter", "l"},
+}
+
+var listsEmacs = map[string][]string{
+	"cursor_up":              {"ctrl+p", "up"},
+	"cursor_up_and_select":   {"alt+p", "shift+up"},
+	"cursor_down":            {"ctrl+n", "down"},
+	"cursor_down_and_select": {"alt+n", "shift+down"},
+	"select":                 {"enter", "ctrl+f"},
+}
+
+var presets = map[string]Overrides{
+	PresetVim: {
+		"navigator": {
+			"back": {"esc", "h"},
+		},
+		"workspaces-list": listsVim,
+		"spaces-list":     listsVim,
+		"folders-list":    listsVim,
+		"lists-list":      listsVim,
+		"tasks": {
+			"open_in_browser":       {"o"},
+			"open_in_browser_batch": {"O"},
+			"refresh":               {"ctrl+r"},
+			"undo":                  {"u"},
+			"redo":                  {"U"},
+		},
+		"tasks-table": {
+			"page_down":  {"ctrl+f", "pgdown"},
+			"page_up":    {"ctrl+b", "pgup"},
+			"page_first": {"g", "home"},
+			"page_last":  {"G", "end"},
+		},
+		"task-sidebar": {
+			"page_down":      {"ctrl+f", "pgdown"},
+			"page_up":        {"ctrl+b", "pgup"},
+			"half_page_up":   {"ctrl+u"},
+			"half_page_down": {"ctrl+d"},
+		},
+	},
+	PresetEmacs: {
+		"ui": {
+			"open_palette": {"alt+x"},
+		},
+		"navigator": {
+			"back": {"esc", "ctrl+b"},
+		},
+		"workspaces-list": listsEmacs,
+		"spaces-list":     listsEmacs,
+		"folders-list":    listsEmacs,
+		"lists-list":      listsEmacs,
+		"tasks-tab": {
+			"cursor_left":             {"ctrl+b", "left"},
+			"cursor_left_and_select":  {"alt+b", "shift+left"},
+			"cursor_right":            {"ctrl+f", "right"},
+			"cursor_right_and_select": {"alt+f", "shift+right"},
+		},
+		"tasks": {
+			"open_in_browser":       {"ctrl+o"},
+			"open_in_browser_batch": {"alt+o"},
+			"lost_focus":            {"esc", "ctrl+g"},
+			"edit_quit":             {"esc", "ctrl+g"},
+			"undo":                  {"ctrl+_"},
+		},
+		"tasks-table": {
+			"row_down":     {"ctrl+n", "down"},
+			"row_up":       {"ctrl+p", "up"},
+			"page_down":    {"ctrl+v", "pgdown"},
+			"page_up":      {"alt+v", "pgup"},
+			"page_first":   {"alt+<", "home"},
+			"page_last":    {"alt+>", "end"},
+			"filter":       {"ctrl+s"},
+			"scroll_right": {"ctrl+f", "shift+right"},
+			"scroll_left":  {"ctrl+b", "shift+left"},
+		},
+		"task-sidebar": {
+			"down":           {"ctrl+n", "down"},
+			"up":             {"ctrl+p", "up"},
+			"page_down":      {"ctrl+v", "pgdown"},
+			"page_up":        {"alt+v", "pgup"},
+			"half_page_up":   {},
+			"half_page_down": {},
+		},
+	},
+}
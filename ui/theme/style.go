@@ -1,16 +1,70 @@
 package theme
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+)
 
 type Style struct {
-	Borders lipgloss.Style
+	Borders        lipgloss.Style
+	TabActive      lipgloss.Style
+	TabInactive    lipgloss.Style
+	TableHighlight lipgloss.Style
+	ListTitle      lipgloss.Style
+	ListItems      list.DefaultItemStyles
+	Archived       lipgloss.Style
+	Help           help.Styles
+	HelpInput      lipgloss.Style
 }
 
-var DefautlStyle = &Style{
-	Borders: lipgloss.NewStyle().
-		BorderStyle(lipgloss.RoundedBorder()).
-		BorderBottom(true).
-		BorderRight(true).
-		BorderTop(true).
-		BorderLeft(true),
+func NewStyle(t *Theme) *Style {
+	items := list.NewDefaultItemStyles()
+	items.SelectedTitle = items.SelectedTitle.
+		BorderForeground(t.ListColorSelectedDesc).
+		Foreground(t.ListColorSelected)
+	items.SelectedDesc = items.SelectedDesc.
+		BorderForeground(t.ListColorSelectedDesc).
+		Foreground(t.ListColorSelectedDesc)
+
+	helpKey := lipgloss.NewStyle().Foreground(t.HelpColorKey)
+	helpDesc := lipgloss.NewStyle().Foreground(t.HelpColorDesc)
+	helpSep := lipgloss.NewStyle().Foreground(t.HelpColorSeparator)
+
+	return &Style{
+		Borders: lipgloss.NewStyle().
+			BorderStyle(lipgloss.RoundedBorder()).
+			BorderBottom(true).
+			BorderRight(true).
+			BorderTop(true).
+			BorderLeft(true),
+		TabActive: lipgloss.NewStyle().
+			Background(t.TabColorActive),
+		TabInactive: lipgloss.NewStyle().
+			Background(t.TabColorInactive),
+		TableHighlight: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(t.TableColorHighlight),
+		ListTitle: lipgloss.NewStyle().
+			Background(t.ListColorTitleBackground).
+			Foreground(t.ListColorTitle).
+			Padding(0, 1),
+		ListItems: items,
+		Archived: lipgloss.NewStyle().
+			Foreground(t.ListColorArchived).
+			Italic(true),
+		Help: help.Styles{
+			ShortKey:       helpKey,
+			ShortDesc:      helpDesc,
+			ShortSeparator: helpSep,
+			Ellipsis:       helpSep,
+			FullKey:        helpKey,
+			FullDesc:       helpDesc,
+			FullSeparator:  helpSep,
+		},
+		HelpInput: lipgloss.NewStyle().
+			Foreground(t.HelpColorInput),
+	}
 }
+
+var DefaultStyle = NewStyle(DefaultTheme)
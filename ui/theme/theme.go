@@ -1,24 +1,185 @@
 package theme
 
 import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
 	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"gopkg.in/yaml.v3"
 )
 
 const (
 	ColorWhite = lipgloss.Color("#FFFFFF")
 	ColorBlack = lipgloss.Color("#000000")
+
+	ThemeDark         = "dark"
+	ThemeLight        = "light"
+	ThemeHighContrast = "high-contrast"
+	ThemeSolarized    = "solarized"
+
+	DefaultThemeName = ThemeDark
+)
+
+var ErrThemeNotFound = errors.New("theme not found")
+
+//go:embed themes/*.yaml
+var builtinThemes embed.FS
+
+type Profile string
+
+const (
+	ProfileTrueColor Profile = "truecolor"
+	ProfileANSI256   Profile = "ansi256"
+	ProfileANSI      Profile = "ansi"
+	ProfileASCII     Profile = "ascii"
 )
 
+func (p Profile) termenv() termenv.Profile {
+	switch p {
+	case ProfileANSI256:
+		return termenv.ANSI256
+	case ProfileANSI:
+		return termenv.ANSI
+	case ProfileASCII:
+		return termenv.Ascii
+	default:
+		return termenv.TrueColor
+	}
+}
+
+// Color is a color given either as a single value or as a pair of values
+// for light and dark terminal backgrounds, e.g.
+//
+//	tab_color_active:
+//	  light: "#874BFD"
+//	  dark: "#7D56F4"
+type Color struct {
+	lipgloss.AdaptiveColor
+}
+
+func (c *Color) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		c.Light, c.Dark = value.Value, value.Value
+		return nil
+	}
+
+	// a pair may leave out one of the values to keep it
+	adaptive := struct {
+		Light string `yaml:"light"`
+		Dark  string `yaml:"dark"`
+	}{c.Light, c.Dark}
+	if err := value.Decode(&adaptive); err != nil {
+		return err
+	}
+
+	c.Light, c.Dark = adaptive.Light, adaptive.Dark
+	return nil
+}
+
 type Theme struct {
-	BordersColorActive   lipgloss.Color
-	BordersColorInactive lipgloss.Color
-	BordersColorCopyMode lipgloss.Color
-	BordersColorEditMode lipgloss.Color
+	Name string `yaml:"name"`
+	// Profile is the minimal color profile required by the theme
+	Profile Profile `yaml:"profile"`
+	// Fallback is used when the terminal does not support the Profile
+	Fallback string `yaml:"fallback"`
+	// MarkdownStyle is the glamour standard style used to render descriptions
+	MarkdownStyle string `yaml:"markdown_style"`
+
+	BordersColorActive   Color `yaml:"borders_color_active"`
+	BordersColorInactive Color `yaml:"borders_color_inactive"`
+	BordersColorCopyMode Color `yaml:"borders_color_copy_mode"`
+	BordersColorEditMode Color `yaml:"borders_color_edit_mode"`
+
+	TabColorActive   Color `yaml:"tab_color_active"`
+	TabColorInactive Color `yaml:"tab_color_inactive"`
+
+	TableColorHighlight Color `yaml:"table_color_highlight"`
+
+	ListColorTitle           Color `yaml:"list_color_title"`
+	ListColorTitleBackground Color `yaml:"list_color_title_background"`
+	ListColorSelected        Color `yaml:"list_color_selected"`
+	ListColorSelectedDesc    Color `yaml:"list_color_selected_desc"`
+	ListColorArchived        Color `yaml:"list_color_archived"`
+
+	HelpColorKey       Color `yaml:"help_color_key"`
+	HelpColorDesc      Color `yaml:"help_color_desc"`
+	HelpColorSeparator Color `yaml:"help_color_separator"`
+	HelpColorInput     Color `yaml:"help_color_input"`
+}
+
+// DefaultTheme is the built-in dark theme. Other themes only override the
+// colors they define.
+var DefaultTheme = mustParseBuiltin(DefaultThemeName)
+
+func mustParseBuiltin(name string) *Theme {
+	data, err := fs.ReadFile(builtinThemes, "themes/"+name+".yaml")
+	if err != nil {
+		panic(err)
+	}
+
+	t := Theme{}
+	if err := yaml.Unmarshal(data, &t); err != nil {
+		panic(fmt.Sprintf("invalid built-in theme %s: %s", name, err))
+	}
+
+	return &t
+}
+
+// Load returns a built-in theme by its name or reads it from a YAML file. A
+// relative path is resolved against dir. If the terminal color profile is
+// not sufficient for the theme, its fallback is loaded instead.
+func Load(name string, dir string, profile termenv.Profile) (*Theme, error) {
+	if name == "" {
+		name = DefaultThemeName
+	}
+
+	t, err := load(name, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	visited := map[string]bool{}
+	for profile > t.Profile.termenv() && t.Fallback != "" && !visited[t.Fallback] {
+		visited[t.Name] = true
+
+		t, err = load(t.Fallback, dir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return t, nil
 }
 
-var DefaultTheme = &Theme{
-	BordersColorActive:   lipgloss.Color("#8909FF"),
-	BordersColorInactive: lipgloss.Color("#FFF"),
-	BordersColorCopyMode: lipgloss.Color("#e6cc00"),
-	BordersColorEditMode: lipgloss.Color("#e6cc00"),
+func load(name string, dir string) (*Theme, error) {
+	data, err := fs.ReadFile(builtinThemes, "themes/"+name+".yaml")
+	if err != nil {
+		path := name
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+
+		data, err = os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrThemeNotFound, name)
+		}
+	}
+
+	// user themes only have to define colors that differ from the default
+	t := *DefaultTheme
+	if err := yaml.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("invalid theme %s: %w", name, err)
+	}
+
+	if t.Name == "" {
+		t.Name = strings.TrimSuffix(filepath.Base(name), filepath.Ext(name))
+	}
+
+	return &t, nil
 }
@@ -5,31 +5,79 @@ import (
 	"time"
 
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/log"
 	"github.com/prgrs/clickup/ui/common"
 	"github.com/prgrs/clickup/ui/context"
+	"github.com/prgrs/clickup/ui/keybindings"
 	"github.com/prgrs/clickup/ui/views/compact"
+	"github.com/prgrs/clickup/ui/views/mywork"
+	"github.com/prgrs/clickup/ui/widgets/cachestats"
 	"github.com/prgrs/clickup/ui/widgets/help"
+	"github.com/prgrs/clickup/ui/widgets/inbox"
+	"github.com/prgrs/clickup/ui/widgets/listpicker"
+	"github.com/prgrs/clickup/ui/widgets/palette"
+	"github.com/prgrs/clickup/ui/widgets/prompt"
+	"github.com/prgrs/clickup/ui/widgets/tagpicker"
+	"github.com/prgrs/clickup/ui/widgets/tasks"
 )
 
 const (
+	id = "ui"
+
 	// refreshInterval = 3
 	refreshInterval = 3000
 )
 
+type view interface {
+	common.UIElement
+	Modes() []string
+	Handlers() keybindings.Handlers
+	IsFiltering() bool
+}
+
+// dialog is rendered over the active view while visible.
+type dialog interface {
+	View() string
+	SetSize(common.Size)
+	IsVisible() bool
+}
+
 type Model struct {
 	ctx    *context.UserContext
 	log    *log.Logger
 	keyMap KeyMap
-
-	viewCompact *compact.Model
-	dialogHelp  *help.Model
+	state  common.Id
+
+	viewCompact   *compact.Model
+	viewMyWork    *mywork.Model
+	dialogHelp    *help.Model
+	dialogPalette *palette.Model
+	dialogInbox   *inbox.Model
+	dialogStats   *cachestats.Model
+	dialogPrompt  *prompt.Model
+	dialogPicker  *listpicker.Model
+	dialogTags    *tagpicker.Model
 }
 
 type KeyMap struct {
-	ForceQuit key.Binding
+	ForceQuit   key.Binding
+	OpenPalette key.Binding
+	SwitchView  key.Binding
+	OpenInbox   key.Binding
+	CacheStats  key.Binding
+}
+
+func (km *KeyMap) Bindings() keybindings.Bindings {
+	return keybindings.Bindings{
+		"force_quit":   &km.ForceQuit,
+		"open_palette": &km.OpenPalette,
+		"switch_view":  &km.SwitchView,
+		"open_inbox":   &km.OpenInbox,
+		"cache_stats":  &km.CacheStats,
+	}
 }
 
 func DefaultKeyMap() KeyMap {
@@ -38,6 +86,22 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("ctrl+c", "q"),
 			key.WithHelp("ctrl+c/q", "quit"),
 		),
+		OpenPalette: key.NewBinding(
+			key.WithKeys(":"),
+			key.WithHelp(":", "command palette"),
+		),
+		SwitchView: key.NewBinding(
+			key.WithKeys("ctrl+w"),
+			key.WithHelp("ctrl+w", "switch view"),
+		),
+		OpenInbox: key.NewBinding(
+			key.WithKeys("I"),
+			key.WithHelp("I", "inbox"),
+		),
+		CacheStats: key.NewBinding(
+			key.WithKeys("ctrl+t"),
+			key.WithHelp("ctrl+t", "cache stats"),
+		),
 	}
 }
 
@@ -45,17 +109,44 @@ func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
 	log := logger.WithPrefix("UI")
 
 	var (
-		viewCompact = compact.InitialModel(ctx, log)
-		dialogHelp  = help.InitialModel(ctx, log)
+		viewCompact   = compact.InitialModel(ctx, log)
+		viewMyWork    = mywork.InitialModel(ctx, log)
+		dialogHelp    = help.InitialModel(ctx, log)
+		dialogPalette = palette.InitialModel(ctx, log)
+		dialogInbox   = inbox.InitialModel(ctx, log)
+		dialogStats   = cachestats.InitialModel(ctx, log)
+		dialogPrompt  = prompt.InitialModel(ctx, log)
+		dialogPicker  = listpicker.InitialModel(ctx, log)
+		dialogTags    = tagpicker.InitialModel(ctx, log)
+		keyMap        = DefaultKeyMap()
 	)
 
+	ctx.KeyBindings.Register(id, keyMap.Bindings(), keybindings.ModeGlobal)
+
 	return Model{
 		ctx:    ctx,
 		log:    log,
-		keyMap: DefaultKeyMap(),
+		keyMap: keyMap,
+		state:  viewCompact.Id(),
+
+		dialogHelp:    &dialogHelp,
+		dialogPalette: &dialogPalette,
+		dialogInbox:   &dialogInbox,
+		dialogStats:   &dialogStats,
+		dialogPrompt:  &dialogPrompt,
+		dialogPicker:  &dialogPicker,
+		dialogTags:    &dialogTags,
+		viewCompact:   &viewCompact,
+		viewMyWork:    &viewMyWork,
+	}
+}
 
-		dialogHelp:  &dialogHelp,
-		viewCompact: &viewCompact,
+func (m Model) activeView() view {
+	switch m.state {
+	case m.viewMyWork.Id():
+		return m.viewMyWork
+	default:
+		return m.viewCompact
 	}
 }
 
@@ -67,11 +158,57 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.log.Error(msg.Error())
 		return m, tea.Quit
 
+	case common.PromptMsg:
+		m.log.Info("Received: common.PromptMsg", "title", msg.Title)
+		return m, m.dialogPrompt.Open(msg)
+
+	case listpicker.OpenMsg:
+		m.log.Info("Received: listpicker.OpenMsg", "title", msg.Title)
+		return m, m.dialogPicker.Open(msg)
+
+	case tagpicker.OpenMsg:
+		m.log.Info("Received: tagpicker.OpenMsg", "title", msg.Title)
+		return m, m.dialogTags.Open(msg)
+
 	case tea.KeyMsg:
-		switch {
-		case key.Matches(msg, m.keyMap.ForceQuit):
-			return m, tea.Quit
+		if m.dialogPrompt.Visible {
+			return m, m.dialogPrompt.Update(msg)
+		}
+
+		if m.dialogPicker.Visible {
+			return m, m.dialogPicker.Update(msg)
+		}
+
+		if m.dialogTags.Visible {
+			return m, m.dialogTags.Update(msg)
+		}
+
+		if m.dialogPalette.Visible {
+			return m, m.dialogPalette.Update(msg)
+		}
+
+		if m.dialogInbox.Visible {
+			return m, m.dialogInbox.Update(msg)
+		}
+
+		if m.dialogStats.Visible {
+			return m, m.dialogStats.Update(msg)
+		}
+
+		if action, ok := keybindings.Match(msg, m.keyMap.Bindings()); ok && !m.typing(msg) {
+			cmd := m.handleAction(action)
+			return m, cmd
+		}
+
+	case palette.RunActionMsg:
+		m.log.Info("Received: palette.RunActionMsg", "action", keybindings.Action(msg).Id())
+		handler, ok := m.handlers()[msg.Component]
+		if !ok {
+			m.log.Error("No handler of the action", "action", keybindings.Action(msg).Id())
+			return m, nil
 		}
+		cmd := handler(msg.Name)
+		return m, cmd
 
 	case tea.WindowSizeMsg:
 		m.log.Debug(
@@ -81,6 +218,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.ctx.WindowSize.Set(msg.Width, msg.Height)
 		return m, tea.Batch(cmds...)
 
+	case TaskEventMsg:
+		m.log.Info("Received: TaskEventMsg", "event", msg.Event, "taskId", msg.TaskId)
+		return m, tea.Batch(
+			common.RefreshCmd(),
+			WaitForTaskEventCmd(m.ctx.TaskEvents),
+		)
+
 	case common.UITickMsg:
 		ts := int64(msg)
 		if time.Now().Unix() > ts {
@@ -94,18 +238,93 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, msg.Tick()
 	}
 
+	switch msg.(type) {
+	case common.RefreshMsg, spinner.TickMsg, tasks.BulkUpdateMsg:
+		cmds = append(cmds,
+			m.viewCompact.Update(msg),
+			m.viewMyWork.Update(msg),
+		)
+	default:
+		cmds = append(cmds, m.activeView().Update(msg))
+	}
+
 	cmds = append(cmds,
-		m.viewCompact.Update(msg),
 		m.dialogHelp.Update(msg),
+		m.dialogPalette.Update(msg),
+		m.dialogInbox.Update(msg),
+		m.dialogPrompt.Update(msg),
+		m.dialogPicker.Update(msg),
+		m.dialogTags.Update(msg),
 	)
 
 	return m, tea.Batch(cmds...)
 }
 
+// typing reports whether the key is typed into a filter of the active view,
+// where only ctrl combinations are global bindings.
+func (m Model) typing(msg tea.KeyMsg) bool {
+	return m.activeView().IsFiltering() && !strings.HasPrefix(msg.String(), "ctrl+")
+}
+
+// handlers returns the handlers of the actions of the ui and of the
+// components of the active view.
+func (m *Model) handlers() keybindings.Handlers {
+	return keybindings.Handlers{id: m.handleAction}.Merge(
+		m.activeView().Handlers(),
+		m.dialogHelp.Handlers(),
+	)
+}
+
+// handleAction runs the global action by its name.
+func (m *Model) handleAction(action string) tea.Cmd {
+	switch action {
+	case "force_quit":
+		return tea.Quit
+
+	case "open_palette":
+		return m.dialogPalette.Open(m.activeView().Modes(), m.handlers())
+
+	case "open_inbox":
+		m.dialogInbox.Open()
+
+	case "cache_stats":
+		m.dialogStats.Open()
+
+	case "switch_view":
+		if m.state == m.viewCompact.Id() {
+			m.state = m.viewMyWork.Id()
+		} else {
+			m.state = m.viewCompact.Id()
+		}
+		m.log.Info("Switched view", "view", m.state)
+		return m.activeView().Update(common.FocusMsg(true))
+	}
+
+	return nil
+}
+
 func (m Model) View() string {
-	var viewToRender common.UIElement = m.viewCompact
+	viewToRender := m.activeView()
 
 	viewKm := viewToRender.Help()
+	if m.dialogPalette.Visible {
+		viewKm = m.dialogPalette.Help()
+	}
+	if m.dialogInbox.Visible {
+		viewKm = m.dialogInbox.Help()
+	}
+	if m.dialogStats.Visible {
+		viewKm = m.dialogStats.Help()
+	}
+	if m.dialogPicker.Visible {
+		viewKm = m.dialogPicker.Help()
+	}
+	if m.dialogTags.Visible {
+		viewKm = m.dialogTags.Help()
+	}
+	if m.dialogPrompt.Visible {
+		viewKm = m.dialogPrompt.Help()
+	}
 
 	km := common.NewHelp(
 		viewKm.FullHelp,
@@ -139,9 +358,24 @@ func (m Model) View() string {
 
 	m.ctx.WindowSize.MetaHeight = lipgloss.Height(divider) + footerHeight
 
+	content := viewToRender.View()
+	for _, dialog := range []dialog{m.dialogPalette, m.dialogInbox, m.dialogStats, m.dialogPicker, m.dialogTags, m.dialogPrompt} {
+		if !dialog.IsVisible() {
+			continue
+		}
+
+		dialog.SetSize(viewToRender.Size())
+		content = lipgloss.Place(
+			physicalWidth, lipgloss.Height(content),
+			lipgloss.Center,
+			lipgloss.Center,
+			dialog.View(),
+		)
+	}
+
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
-		viewToRender.View(),
+		content,
 		divider,
 		footer,
 	)
@@ -150,8 +384,16 @@ func (m Model) View() string {
 func (m Model) Init() tea.Cmd {
 	m.log.Info("Initializing...")
 	return tea.Batch(
+		WaitForTaskEventCmd(m.ctx.TaskEvents),
 		m.viewCompact.Init(),
+		m.viewMyWork.Init(),
 		m.dialogHelp.Init(),
+		m.dialogPalette.Init(),
+		m.dialogInbox.Init(),
+		m.dialogStats.Init(),
+		m.dialogPrompt.Init(),
+		m.dialogPicker.Init(),
+		m.dialogTags.Init(),
 		common.UITickCmd(refreshInterval),
 	)
 }
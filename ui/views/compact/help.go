@@ -17,3 +17,25 @@ func (m Model) Help() help.KeyMap {
 		return common.NewEmptyHelp()
 	}
 }
+
+// IsFiltering reports whether keys are typed into a filter of the focused
+// widget.
+func (m Model) IsFiltering() bool {
+	return m.state == m.widgetTasks.Id() && m.widgetTasks.IsFiltering()
+}
+
+// Modes returns the key binding modes of the view and its focused widget.
+func (m Model) Modes() []string {
+	modes := []string{id}
+
+	switch m.state {
+	case m.widgetNavigator.Id():
+		return append(modes, m.widgetNavigator.Modes()...)
+	case m.widgetViewsTabs.Id():
+		return append(modes, m.widgetViewsTabs.Modes()...)
+	case m.widgetTasks.Id():
+		return append(modes, m.widgetTasks.Modes()...)
+	default:
+		return modes
+	}
+}
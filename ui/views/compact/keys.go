@@ -1,13 +1,66 @@
 package compact
 
-import tea "github.com/charmbracelet/bubbletea"
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prgrs/clickup/ui/keybindings"
+)
+
+type KeyMap struct {
+	SwitchFocus key.Binding
+}
+
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		SwitchFocus: key.NewBinding(
+			key.WithKeys("tab"),
+			key.WithHelp("tab", "switch focus"),
+		),
+	}
+}
+
+func (km *KeyMap) Bindings() keybindings.Bindings {
+	return keybindings.Bindings{
+		"switch_focus": &km.SwitchFocus,
+	}
+}
 
 func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
 	var cmd tea.Cmd
 	var cmds []tea.Cmd
 
-	switch keypress := msg.String(); keypress {
-	case "tab":
+	if action, ok := keybindings.Match(msg, m.keyMap.Bindings()); ok {
+		cmds = append(cmds, m.handleAction(action))
+	}
+
+	switch m.state {
+	case m.widgetNavigator.Id():
+		cmd = m.widgetNavigator.Update(msg)
+	case m.widgetViewsTabs.Id():
+		cmd = m.widgetViewsTabs.Update(msg)
+	case m.widgetTasks.Id():
+		cmd = m.widgetTasks.Update(msg)
+	}
+
+	m.widgetViewsTabs.Path = m.widgetNavigator.GetPath()
+
+	cmds = append(cmds, cmd)
+	return tea.Batch(cmds...)
+}
+
+// Handlers returns the handlers of the actions of the view and its widgets.
+func (m *Model) Handlers() keybindings.Handlers {
+	return keybindings.Handlers{id: m.handleAction}.Merge(
+		m.widgetNavigator.Handlers(),
+		m.widgetViewsTabs.Handlers(),
+		m.widgetTasks.Handlers(),
+	)
+}
+
+// handleAction runs the action of the view by its name.
+func (m *Model) handleAction(action string) tea.Cmd {
+	switch action {
+	case "switch_focus":
 		switch m.state {
 		case m.widgetNavigator.Id():
 			m.state = m.widgetTasks.Id()
@@ -27,17 +80,5 @@ func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
 		}
 	}
 
-	switch m.state {
-	case m.widgetNavigator.Id():
-		cmd = m.widgetNavigator.Update(msg)
-	case m.widgetViewsTabs.Id():
-		cmd = m.widgetViewsTabs.Update(msg)
-	case m.widgetTasks.Id():
-		cmd = m.widgetTasks.Update(msg)
-	}
-
-	m.widgetViewsTabs.Path = m.widgetNavigator.GetPath()
-
-	cmds = append(cmds, cmd)
-	return tea.Batch(cmds...)
+	return nil
 }
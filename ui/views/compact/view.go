@@ -26,6 +26,7 @@ type Model struct {
 	size        common.Size
 	spinner     spinner.Model
 	showSpinner bool
+	keyMap      KeyMap
 
 	widgetNavigator *navigator.Model
 	widgetViewsTabs *viewstabs.Model
@@ -226,6 +227,9 @@ func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
 
 	log := common.NewLogger(logger, common.ResourceTypeRegistry.VIEW, id)
 
+	keyMap := DefaultKeyMap()
+	ctx.KeyBindings.Register(id, keyMap.Bindings(), id)
+
 	var (
 		widgetViewsTabs = viewstabs.InitialModel(ctx, log)
 		widgetTasks     = tasks.InitialModel(ctx, log)
@@ -237,6 +241,7 @@ func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
 		ctx:             ctx,
 		spinner:         s,
 		showSpinner:     true,
+		keyMap:          keyMap,
 		log:             log,
 		widgetViewsTabs: &widgetViewsTabs,
 		widgetNavigator: &widgetNavigator,
@@ -258,6 +263,8 @@ func viewsToTabs(views []clickup.View) []viewstabs.Tab {
 }
 
 func (m *Model) reloadTasks(viewId string) error {
+	m.ctx.Api.SetVisible(api.CacheNamespaceTasksView, viewId)
+
 	tasks, err := m.ctx.Api.GetTasksFromView(viewId)
 	if err != nil {
 		return err
@@ -268,18 +275,27 @@ func (m *Model) reloadTasks(viewId string) error {
 }
 
 func (m *Model) handleWorkspaceChangePreview(id string) tea.Cmd {
+	m.widgetTasks.SelectedListId = ""
 	return m.handleChangePreview(id, m.ctx.Api.GetViewsFromWorkspace) // TODO: should fetch from the list
 }
 
 func (m *Model) handleSpaceChangePreview(id string) tea.Cmd {
+	m.widgetTasks.SelectedListId = ""
 	return m.handleChangePreview(id, m.ctx.Api.GetViewsFromSpace)
 }
 
 func (m *Model) handleFolderChangePreview(id string) tea.Cmd {
+	m.widgetTasks.SelectedListId = ""
+
+	if spaceId, ok := api.ParseFolderlessFolderId(id); ok {
+		return m.handleChangePreview(spaceId, m.ctx.Api.GetViewsFromSpace)
+	}
+
 	return m.handleChangePreview(id, m.ctx.Api.GetViewsFromFolder)
 }
 
 func (m *Model) handleListChangePreview(id string) tea.Cmd {
+	m.widgetTasks.SelectedListId = id
 	return m.handleChangePreview(id, m.ctx.Api.GetViewsFromList)
 }
 
@@ -0,0 +1,82 @@
+package mywork
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/prgrs/clickup/pkg/clickup"
+	viewstabs "github.com/prgrs/clickup/ui/components/views-tabs"
+)
+
+type bucket string
+
+const (
+	bucketOverdue  bucket = "overdue"
+	bucketToday    bucket = "today"
+	bucketThisWeek bucket = "this-week"
+	bucketLater    bucket = "later"
+	bucketNoDate   bucket = "no-date"
+)
+
+var buckets = []struct {
+	id   bucket
+	name string
+}{
+	{bucketOverdue, "Overdue"},
+	{bucketToday, "Today"},
+	{bucketThisWeek, "This week"},
+	{bucketLater, "Later"},
+	{bucketNoDate, "No date"},
+}
+
+// groupByDue groups tasks by their due date relative to now. Weeks start on
+// Monday. Tasks within a bucket are sorted by their due date.
+func groupByDue(tasks []clickup.Task, now time.Time) map[bucket][]clickup.Task {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	tomorrow := today.AddDate(0, 0, 1)
+	nextWeek := today.AddDate(0, 0, 7-(int(today.Weekday())+6)%7)
+
+	groups := map[bucket][]clickup.Task{}
+	for _, task := range tasks {
+		due, ok := task.GetDueDate()
+
+		var b bucket
+		switch {
+		case !ok:
+			b = bucketNoDate
+		case due.Before(today):
+			b = bucketOverdue
+		case due.Before(tomorrow):
+			b = bucketToday
+		case due.Before(nextWeek):
+			b = bucketThisWeek
+		default:
+			b = bucketLater
+		}
+
+		groups[b] = append(groups[b], task)
+	}
+
+	for _, tasks := range groups {
+		sort.SliceStable(tasks, func(i, j int) bool {
+			a, _ := tasks[i].GetDueDate()
+			b, _ := tasks[j].GetDueDate()
+			return a.Before(b)
+		})
+	}
+
+	return groups
+}
+
+func bucketsToTabs(groups map[bucket][]clickup.Task) []viewstabs.Tab {
+	tabs := make([]viewstabs.Tab, len(buckets))
+	for i, b := range buckets {
+		tabs[i] = viewstabs.Tab{
+			Name: fmt.Sprintf("%s (%d)", b.name, len(groups[b.id])),
+			Id:   string(b.id),
+		}
+	}
+
+	return tabs
+}
@@ -0,0 +1,9 @@
+package mywork
+
+import tea "github.com/charmbracelet/bubbletea"
+
+type LoadingMyTasksMsg string
+
+func LoadingMyTasksCmd() tea.Cmd {
+	return func() tea.Msg { return LoadingMyTasksMsg("") }
+}
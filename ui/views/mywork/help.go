@@ -0,0 +1,47 @@
+package mywork
+
+import (
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/prgrs/clickup/ui/common"
+)
+
+func (m Model) Help() help.KeyMap {
+	var h help.KeyMap
+
+	switch m.state {
+	case m.widgetTabs.Id():
+		h = m.widgetTabs.Help()
+	case m.widgetTasks.Id():
+		h = m.widgetTasks.Help()
+	default:
+		return common.NewEmptyHelp()
+	}
+
+	return common.NewHelp(
+		func() [][]key.Binding {
+			return append(h.FullHelp(), []key.Binding{m.keyMap.SwitchFocus})
+		},
+		h.ShortHelp,
+	)
+}
+
+// IsFiltering reports whether keys are typed into a filter of the focused
+// widget.
+func (m Model) IsFiltering() bool {
+	return m.state == m.widgetTasks.Id() && m.widgetTasks.IsFiltering()
+}
+
+// Modes returns the key binding modes of the view and its focused widget.
+func (m Model) Modes() []string {
+	modes := []string{id}
+
+	switch m.state {
+	case m.widgetTabs.Id():
+		return append(modes, m.widgetTabs.Modes()...)
+	case m.widgetTasks.Id():
+		return append(modes, m.widgetTasks.Modes()...)
+	default:
+		return modes
+	}
+}
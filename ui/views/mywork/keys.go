@@ -0,0 +1,64 @@
+package mywork
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prgrs/clickup/ui/keybindings"
+)
+
+type KeyMap struct {
+	SwitchFocus key.Binding
+}
+
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		SwitchFocus: key.NewBinding(
+			key.WithKeys("tab"),
+			key.WithHelp("tab", "switch focus"),
+		),
+	}
+}
+
+func (km *KeyMap) Bindings() keybindings.Bindings {
+	return keybindings.Bindings{
+		"switch_focus": &km.SwitchFocus,
+	}
+}
+
+func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
+	if action, ok := keybindings.Match(msg, m.keyMap.Bindings()); ok {
+		return m.handleAction(action)
+	}
+
+	switch m.state {
+	case m.widgetTabs.Id():
+		return m.widgetTabs.Update(msg)
+	case m.widgetTasks.Id():
+		return m.widgetTasks.Update(msg)
+	}
+
+	return nil
+}
+
+// Handlers returns the handlers of the actions of the view and its widgets.
+func (m *Model) Handlers() keybindings.Handlers {
+	return keybindings.Handlers{id: m.handleAction}.Merge(
+		m.widgetTabs.Handlers(),
+		m.widgetTasks.Handlers(),
+	)
+}
+
+// handleAction runs the action of the view by its name.
+func (m *Model) handleAction(action string) tea.Cmd {
+	switch action {
+	case "switch_focus":
+		switch m.state {
+		case m.widgetTabs.Id():
+			m.setFocus(m.widgetTasks.Id())
+		case m.widgetTasks.Id():
+			m.setFocus(m.widgetTabs.Id())
+		}
+	}
+
+	return nil
+}
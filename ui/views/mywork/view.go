@@ -0,0 +1,256 @@
+package mywork
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/prgrs/clickup/api"
+	"github.com/prgrs/clickup/pkg/clickup"
+	"github.com/prgrs/clickup/ui/common"
+	viewstabs "github.com/prgrs/clickup/ui/components/views-tabs"
+	"github.com/prgrs/clickup/ui/context"
+	"github.com/prgrs/clickup/ui/widgets/tasks"
+)
+
+const id = "my-work"
+
+// Model is a view of tasks assigned to the authorized user across all lists
+// of the workspace, grouped by their due date.
+type Model struct {
+	id     common.Id
+	ctx    *context.UserContext
+	log    *log.Logger
+	state  common.Id
+	size   common.Size
+	keyMap KeyMap
+	loaded bool
+
+	widgetTabs  *viewstabs.Model
+	widgetTasks *tasks.Model
+}
+
+func (m Model) Size() common.Size {
+	return m.size
+}
+
+func (m Model) Id() common.Id {
+	return m.id
+}
+
+func (m Model) Init() tea.Cmd {
+	m.log.Info("Initializing...")
+	return nil
+}
+
+func (m *Model) SetSize(size common.Size) {
+	m.size = size
+}
+
+func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
+	log := common.NewLogger(logger, common.ResourceTypeRegistry.VIEW, id)
+
+	keyMap := DefaultKeyMap()
+	ctx.KeyBindings.Register(id, keyMap.Bindings(), id)
+
+	var (
+		widgetTabs  = viewstabs.InitialModel(ctx, log).WithTitle("My Work")
+		widgetTasks = tasks.InitialModel(ctx, log)
+	)
+	widgetTasks.SetFocused(true)
+
+	return Model{
+		id:          id,
+		ctx:         ctx,
+		log:         log,
+		keyMap:      keyMap,
+		widgetTabs:  &widgetTabs,
+		widgetTasks: &widgetTasks,
+		state:       widgetTasks.Id(),
+	}
+}
+
+func (m *Model) Update(msg tea.Msg) tea.Cmd {
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m.handleKeys(msg)
+
+	case common.FocusMsg:
+		m.log.Info("Received: common.FocusMsg")
+		if !m.loaded {
+			m.widgetTasks.SetSpinner(true)
+			return LoadingMyTasksCmd()
+		}
+
+	case LoadingMyTasksMsg:
+		m.log.Info("Received: LoadingMyTasksMsg")
+		if err := m.reload(); err != nil {
+			return common.ErrCmd(err)
+		}
+		m.loaded = true
+
+		return nil
+
+	case viewstabs.TabChangedMsg:
+		id := string(msg)
+		m.log.Info("Received: TabChangedMsg", "id", id)
+		if err := m.reload(); err != nil {
+			return common.ErrCmd(err)
+		}
+		m.setFocus(m.widgetTasks.Id())
+
+	case common.RefreshMsg:
+		m.log.Debug("Received: common.RefreshMsg")
+		if !m.loaded {
+			return nil
+		}
+
+		if err := m.refreshTabs(); err != nil {
+			return common.ErrCmd(err)
+		}
+
+	case tasks.LostFocusMsg:
+		m.log.Info("Received: tasks.LostFocusMsg")
+		m.setFocus(m.widgetTabs.Id())
+	}
+
+	cmds = append(cmds,
+		m.widgetTabs.Update(msg),
+		m.widgetTasks.Update(msg),
+	)
+
+	return tea.Batch(cmds...)
+}
+
+func (m Model) View() string {
+	size := m.ctx.WindowSize
+	size.Height -= size.MetaHeight
+
+	m.widgetTabs.SetSize(common.Size{
+		Width: size.Width,
+	})
+	widgetTabsRendered := m.widgetTabs.View()
+
+	m.widgetTasks.SetSize(common.Size{
+		Width:  size.Width,
+		Height: size.Height - lipgloss.Height(widgetTabsRendered),
+	})
+
+	return lipgloss.JoinVertical(
+		lipgloss.Top,
+		widgetTabsRendered,
+		m.widgetTasks.View(),
+	)
+}
+
+func (m *Model) setFocus(state common.Id) {
+	m.state = state
+	m.widgetTabs.SetFocused(state == m.widgetTabs.Id())
+	m.widgetTasks.SetFocused(state == m.widgetTasks.Id())
+}
+
+// reload refreshes bucket counts and shows the tasks of the selected bucket.
+func (m *Model) reload() error {
+	groups, err := m.fetchGroups(true)
+	if err != nil {
+		return err
+	}
+
+	m.setTabs(groups)
+
+	selected := bucket(m.widgetTabs.Selected)
+	m.widgetTasks.SetFetcher(m.bucketFetcher(selected))
+	m.widgetTasks.SetTasks(groups[selected])
+
+	return nil
+}
+
+func (m *Model) refreshTabs() error {
+	groups, err := m.fetchGroups(true)
+	if err != nil {
+		return err
+	}
+
+	m.setTabs(groups)
+
+	return nil
+}
+
+// setTabs replaces tabs keeping the selected one.
+func (m *Model) setTabs(groups map[bucket][]clickup.Task) {
+	selected := m.widgetTabs.Selected
+	m.widgetTabs.SetTabs(bucketsToTabs(groups))
+
+	for i, b := range buckets {
+		if string(b.id) == selected {
+			m.widgetTabs.SelectedIdx = i
+			m.widgetTabs.Selected = selected
+		}
+	}
+}
+
+func (m *Model) fetchGroups(cached bool) (map[bucket][]clickup.Task, error) {
+	tasks, err := fetchMyTasks(m.ctx.Api, m.ctx.Config.DefaultWorkspace, cached)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupByDue(tasks, time.Now()), nil
+}
+
+func (m *Model) bucketFetcher(b bucket) tasks.TasksFetcher {
+	a := m.ctx.Api
+	teamId := m.ctx.Config.DefaultWorkspace
+
+	return func(cached bool) ([]clickup.Task, error) {
+		tasks, err := fetchMyTasks(a, teamId, cached)
+		if err != nil {
+			return nil, err
+		}
+
+		if tasks = groupByDue(tasks, time.Now())[b]; tasks == nil {
+			tasks = []clickup.Task{}
+		}
+
+		return tasks, nil
+	}
+}
+
+// fetchMyTasks returns tasks assigned to the authorized user in the given
+// team or in all authorized teams if teamId is empty.
+func fetchMyTasks(a *api.Api, teamId string, cached bool) ([]clickup.Task, error) {
+	teamIds := []string{teamId}
+
+	if teamId == "" {
+		teams, err := a.GetTeams()
+		if err != nil {
+			return nil, err
+		}
+
+		teamIds = make([]string, len(teams))
+		for i, team := range teams {
+			teamIds[i] = team.Id
+		}
+	}
+
+	a.SetVisible(api.CacheNamespaceTasksAssigned, teamIds...)
+
+	get := a.GetMyTasks
+	if !cached {
+		get = a.SyncMyTasks
+	}
+
+	tasks := []clickup.Task{}
+	for _, teamId := range teamIds {
+		t, err := get(teamId)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, t...)
+	}
+
+	return tasks, nil
+}
@@ -0,0 +1,18 @@
+package cachestats
+
+import (
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/prgrs/clickup/ui/common"
+)
+
+func (m Model) Help() help.KeyMap {
+	return common.NewHelp(
+		func() [][]key.Binding {
+			return [][]key.Binding{{m.keyMap.Close}}
+		},
+		func() []key.Binding {
+			return []key.Binding{m.keyMap.Close}
+		},
+	)
+}
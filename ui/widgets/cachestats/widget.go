@@ -0,0 +1,94 @@
+package cachestats
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/prgrs/clickup/ui/common"
+	"github.com/prgrs/clickup/ui/context"
+)
+
+const id = "cache-stats"
+
+// Model is a debug overlay showing usage of the cache.
+type Model struct {
+	id     common.Id
+	ctx    *context.UserContext
+	log    *log.Logger
+	size   common.Size
+	keyMap KeyMap
+
+	Visible bool
+}
+
+func (m Model) Id() common.Id {
+	return m.id
+}
+
+func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
+	log := common.NewLogger(logger, common.ResourceTypeRegistry.WIDGET, id)
+
+	keyMap := DefaultKeyMap()
+	ctx.KeyBindings.Register(id, keyMap.Bindings(), id)
+
+	return Model{
+		id:      id,
+		ctx:     ctx,
+		log:     log,
+		keyMap:  keyMap,
+		Visible: false,
+	}
+}
+
+func (m *Model) Open() {
+	m.log.Debug("Opening cache stats")
+	m.Visible = true
+}
+
+func (m Model) IsVisible() bool {
+	return m.Visible
+}
+
+func (m *Model) Close() {
+	m.Visible = false
+}
+
+func (m *Model) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m.handleKeys(msg)
+	}
+
+	return nil
+}
+
+func (m Model) View() string {
+	s := strings.Builder{}
+	if err := m.ctx.Api.Cache.Stats().Write(&s); err != nil {
+		s.WriteString(err.Error())
+	}
+
+	return m.ctx.Style.Borders.
+		BorderForeground(m.ctx.Theme.BordersColorActive).
+		MaxWidth(m.size.Width).
+		Render(lipgloss.JoinVertical(lipgloss.Left,
+			"Cache stats",
+			"",
+			strings.TrimSuffix(s.String(), "\n"),
+		))
+}
+
+func (m Model) Init() tea.Cmd {
+	m.log.Info("Initializing...")
+	return nil
+}
+
+func (m *Model) SetSize(s common.Size) {
+	m.size = s
+}
+
+func (m Model) Size() common.Size {
+	return m.size
+}
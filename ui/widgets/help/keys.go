@@ -3,6 +3,7 @@ package help
 import (
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prgrs/clickup/ui/keybindings"
 )
 
 type KeyMap struct {
@@ -18,11 +19,31 @@ func DefaultKeyMap() KeyMap {
 	}
 }
 
+func (km *KeyMap) Bindings() keybindings.Bindings {
+	return keybindings.Bindings{
+		"show_help": &km.ShowHelp,
+	}
+}
+
 func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
 	m.lastKey = msg.String()
 
-	switch {
-	case key.Matches(msg, m.keyMap.ShowHelp):
+	if action, ok := keybindings.Match(msg, m.keyMap.Bindings()); ok {
+		return m.handleAction(action)
+	}
+
+	return nil
+}
+
+// Handlers returns the handler of the actions of the help widget.
+func (m *Model) Handlers() keybindings.Handlers {
+	return keybindings.Handlers{id: m.handleAction}
+}
+
+// handleAction runs the action of the help widget by its name.
+func (m *Model) handleAction(action string) tea.Cmd {
+	switch action {
+	case "show_help":
 		m.ShowHelp = !m.ShowHelp
 		m.help.ShowAll = !m.help.ShowAll
 	}
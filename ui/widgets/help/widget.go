@@ -1,6 +1,7 @@
 package help
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/help"
@@ -9,6 +10,7 @@ import (
 	"github.com/charmbracelet/log"
 	"github.com/prgrs/clickup/ui/common"
 	"github.com/prgrs/clickup/ui/context"
+	"github.com/prgrs/clickup/ui/keybindings"
 )
 
 const id = "help"
@@ -32,14 +34,20 @@ func (m Model) Id() common.Id {
 func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
 	log := common.NewLogger(logger, common.ResourceTypeRegistry.WIDGET, id)
 
+	keyMap := DefaultKeyMap()
+	ctx.KeyBindings.Register(id, keyMap.Bindings(), keybindings.ModeGlobal)
+
+	h := help.New()
+	h.Styles = ctx.Style.Help
+
 	return Model{
-		inputStyle: lipgloss.NewStyle().Foreground(lipgloss.Color("#FF75B7")),
+		inputStyle: ctx.Style.HelpInput,
 		id:         id,
 		ctx:        ctx,
 		log:        log,
-		help:       help.New(),
+		help:       h,
 		ShowHelp:   false,
-		keyMap:     DefaultKeyMap(),
+		keyMap:     keyMap,
 	}
 }
 
@@ -66,6 +74,10 @@ func (m Model) View(keyMap help.KeyMap) string {
 		status = " You chose: " + m.inputStyle.Render(m.lastKey) + " "
 	}
 
+	if unread := m.ctx.Api.Inbox.Unread(); unread > 0 {
+		status = m.inputStyle.Render(fmt.Sprintf(" ● %d unread ", unread)) + status
+	}
+
 	availableWidth -= lipgloss.Width(status)
 
 	m.help.Width = availableWidth
@@ -0,0 +1,32 @@
+package inbox
+
+import (
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/prgrs/clickup/ui/common"
+)
+
+func (m Model) Help() help.KeyMap {
+	return common.NewHelp(
+		func() [][]key.Binding {
+			return [][]key.Binding{
+				{
+					m.keyMap.Up,
+					m.keyMap.Down,
+					m.keyMap.MarkRead,
+					m.keyMap.MarkAllRead,
+					m.keyMap.OpenInBrowser,
+					m.keyMap.Close,
+				},
+			}
+		},
+		func() []key.Binding {
+			return []key.Binding{
+				m.keyMap.MarkRead,
+				m.keyMap.MarkAllRead,
+				m.keyMap.OpenInBrowser,
+				m.keyMap.Close,
+			}
+		},
+	)
+}
@@ -0,0 +1,99 @@
+package inbox
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prgrs/clickup/ui/common"
+	"github.com/prgrs/clickup/ui/keybindings"
+)
+
+type KeyMap struct {
+	Up            key.Binding
+	Down          key.Binding
+	MarkRead      key.Binding
+	MarkAllRead   key.Binding
+	OpenInBrowser key.Binding
+	Close         key.Binding
+}
+
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Up: key.NewBinding(
+			key.WithKeys("k", "up"),
+			key.WithHelp("k, up", "up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("j", "down"),
+			key.WithHelp("j, down", "down"),
+		),
+		MarkRead: key.NewBinding(
+			key.WithKeys("enter", "r"),
+			key.WithHelp("enter, r", "mark as read"),
+		),
+		MarkAllRead: key.NewBinding(
+			key.WithKeys("R"),
+			key.WithHelp("R", "mark all as read"),
+		),
+		OpenInBrowser: key.NewBinding(
+			key.WithKeys("o"),
+			key.WithHelp("o", "open in browser"),
+		),
+		Close: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "close inbox"),
+		),
+	}
+}
+
+func (km *KeyMap) Bindings() keybindings.Bindings {
+	return keybindings.Bindings{
+		"up":              &km.Up,
+		"down":            &km.Down,
+		"mark_read":       &km.MarkRead,
+		"mark_all_read":   &km.MarkAllRead,
+		"open_in_browser": &km.OpenInBrowser,
+		"close":           &km.Close,
+	}
+}
+
+func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, m.keyMap.Close):
+		m.Close()
+
+	case key.Matches(msg, m.keyMap.Up):
+		if m.cursor > 0 {
+			m.cursor--
+		}
+
+	case key.Matches(msg, m.keyMap.Down):
+		if m.cursor < len(m.items)-1 {
+			m.cursor++
+		}
+
+	case key.Matches(msg, m.keyMap.MarkRead):
+		if len(m.items) == 0 {
+			break
+		}
+		m.ctx.Api.Inbox.MarkRead(m.items[m.cursor].Id)
+		m.reload()
+
+	case key.Matches(msg, m.keyMap.MarkAllRead):
+		m.ctx.Api.Inbox.MarkAllRead()
+		m.reload()
+
+	case key.Matches(msg, m.keyMap.OpenInBrowser):
+		if len(m.items) == 0 {
+			break
+		}
+		item := m.items[m.cursor]
+		m.log.Debug("Opening task in the web browser", "url", item.Url)
+		m.ctx.Api.Inbox.MarkRead(item.Id)
+		m.reload()
+		if err := common.OpenUrlInWebBrowser(item.Url); err != nil {
+			return common.ErrCmd(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package inbox
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/prgrs/clickup/api"
+	"github.com/prgrs/clickup/ui/common"
+	"github.com/prgrs/clickup/ui/context"
+)
+
+const (
+	id = "inbox"
+
+	maxWidth   = 80
+	maxVisible = 8
+)
+
+// Model is a dialog listing changes of tasks detected while syncing.
+type Model struct {
+	id     common.Id
+	ctx    *context.UserContext
+	log    *log.Logger
+	size   common.Size
+	keyMap KeyMap
+	items  []api.Notification
+	cursor int
+
+	Visible bool
+}
+
+func (m Model) Id() common.Id {
+	return m.id
+}
+
+func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
+	log := common.NewLogger(logger, common.ResourceTypeRegistry.WIDGET, id)
+
+	keyMap := DefaultKeyMap()
+	ctx.KeyBindings.Register(id, keyMap.Bindings(), id)
+
+	return Model{
+		id:      id,
+		ctx:     ctx,
+		log:     log,
+		keyMap:  keyMap,
+		Visible: false,
+	}
+}
+
+func (m *Model) Open() {
+	m.log.Debug("Opening inbox")
+	m.Visible = true
+	m.cursor = 0
+	m.reload()
+}
+
+func (m Model) IsVisible() bool {
+	return m.Visible
+}
+
+func (m *Model) Close() {
+	m.Visible = false
+}
+
+func (m *Model) reload() {
+	m.items = m.ctx.Api.Inbox.Items()
+	if m.cursor >= len(m.items) {
+		m.cursor = max(0, len(m.items)-1)
+	}
+}
+
+func (m *Model) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m.handleKeys(msg)
+
+	case common.RefreshMsg:
+		if m.Visible {
+			m.reload()
+		}
+	}
+
+	return nil
+}
+
+func (m Model) View() string {
+	width := min(maxWidth, m.size.Width-4)
+	if width < 0 {
+		width = 0
+	}
+
+	rows := []string{
+		fmt.Sprintf("Inbox (%d unread)", m.ctx.Api.Inbox.Unread()),
+		"",
+	}
+
+	if len(m.items) == 0 {
+		rows = append(rows, "No changes yet")
+	}
+
+	start := 0
+	if m.cursor >= maxVisible {
+		start = m.cursor - maxVisible + 1
+	}
+	end := min(start+maxVisible, len(m.items))
+
+	for i := start; i < end; i++ {
+		item := m.items[i]
+
+		marker := "  "
+		if !item.Read {
+			marker = "● "
+		}
+
+		title := marker + item.TaskName
+		ts := item.Ts.Format("15:04")
+
+		gap := width - lipgloss.Width(title) - lipgloss.Width(ts)
+		if gap < 1 {
+			gap = 1
+		}
+
+		style := lipgloss.NewStyle()
+		if i == m.cursor {
+			style = m.ctx.Style.TableHighlight
+		}
+
+		rows = append(rows, style.Render(title+strings.Repeat(" ", gap)+ts))
+		for _, change := range item.Changes {
+			rows = append(rows, m.ctx.Style.Help.ShortDesc.Render("    "+change.String()))
+		}
+	}
+
+	return m.ctx.Style.Borders.
+		BorderForeground(m.ctx.Theme.BordersColorActive).
+		Width(width).
+		Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+func (m Model) Init() tea.Cmd {
+	m.log.Info("Initializing...")
+	return nil
+}
+
+func (m *Model) SetSize(s common.Size) {
+	m.size = s
+}
+
+func (m Model) Size() common.Size {
+	return m.size
+}
@@ -0,0 +1,19 @@
+package listpicker
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prgrs/clickup/pkg/clickup"
+)
+
+// OpenMsg opens the picker with lists of the workspace.
+type OpenMsg struct {
+	Title  string
+	TeamId string
+	// OnPick is called with the picked list. It is not called if the picker
+	// is closed.
+	OnPick func(list clickup.List) tea.Cmd
+}
+
+func OpenCmd(msg OpenMsg) tea.Cmd {
+	return func() tea.Msg { return msg }
+}
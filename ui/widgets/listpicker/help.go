@@ -0,0 +1,30 @@
+package listpicker
+
+import (
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/prgrs/clickup/ui/common"
+)
+
+func (m Model) Help() help.KeyMap {
+	return common.NewHelp(
+		func() [][]key.Binding {
+			return [][]key.Binding{
+				{
+					m.keyMap.Up,
+					m.keyMap.Down,
+					m.keyMap.Pick,
+					m.keyMap.Close,
+				},
+			}
+		},
+		func() []key.Binding {
+			return []key.Binding{
+				m.keyMap.Up,
+				m.keyMap.Down,
+				m.keyMap.Pick,
+				m.keyMap.Close,
+			}
+		},
+	)
+}
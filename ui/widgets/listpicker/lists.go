@@ -0,0 +1,79 @@
+package listpicker
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/prgrs/clickup/api"
+	"github.com/prgrs/clickup/pkg/clickup"
+	"golang.org/x/sync/errgroup"
+)
+
+// entry is a list with its path in the navigator hierarchy.
+type entry struct {
+	list clickup.List
+	path string
+}
+
+// fetchEntries walks spaces and folders of the workspace, folderless lists
+// included, in the same order as the navigator. Archived lists are skipped.
+func fetchEntries(a *api.Api, teamId string) ([]entry, error) {
+	spaces, err := a.GetSpaces(teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([][]entry, len(spaces))
+
+	g := new(errgroup.Group)
+	g.SetLimit(api.SyncConcurrency)
+
+	for i, space := range spaces {
+		g.Go(func() error {
+			entries, err := fetchSpaceEntries(a, space)
+			results[i] = entries
+			return err
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	entries := []entry{}
+	for _, r := range results {
+		entries = append(entries, r...)
+	}
+
+	return entries, nil
+}
+
+func fetchSpaceEntries(a *api.Api, space clickup.Space) ([]entry, error) {
+	folders, err := a.GetFolders(space.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	folders = append(slices.Clip(folders), api.FolderlessFolder(space.Id))
+
+	entries := []entry{}
+	for _, folder := range folders {
+		lists, err := a.GetLists(folder.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, list := range lists {
+			if list.Archived {
+				continue
+			}
+
+			entries = append(entries, entry{
+				list: list,
+				path: strings.Join([]string{space.Name, folder.Name, list.Name}, " / "),
+			})
+		}
+	}
+
+	return entries, nil
+}
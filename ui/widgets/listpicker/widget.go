@@ -0,0 +1,198 @@
+package listpicker
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/prgrs/clickup/ui/common"
+	"github.com/prgrs/clickup/ui/context"
+	"github.com/sahilm/fuzzy"
+)
+
+const (
+	id = "list-picker"
+
+	maxWidth   = 80
+	maxVisible = 12
+)
+
+// Model is a dialog picking a list of the workspace by fuzzy matching its
+// path in the navigator hierarchy.
+type Model struct {
+	id       common.Id
+	ctx      *context.UserContext
+	log      *log.Logger
+	size     common.Size
+	keyMap   KeyMap
+	input    textinput.Model
+	request  OpenMsg
+	entries  []entry
+	filtered []entry
+	cursor   int
+
+	Visible bool
+}
+
+func (m Model) Id() common.Id {
+	return m.id
+}
+
+func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
+	log := common.NewLogger(logger, common.ResourceTypeRegistry.WIDGET, id)
+
+	keyMap := DefaultKeyMap()
+	ctx.KeyBindings.Register(id, keyMap.Bindings(), id)
+
+	input := textinput.New()
+	input.Prompt = "> "
+	input.Placeholder = "Type to search lists..."
+
+	return Model{
+		id:      id,
+		ctx:     ctx,
+		log:     log,
+		keyMap:  keyMap,
+		input:   input,
+		Visible: false,
+	}
+}
+
+func (m *Model) Open(request OpenMsg) tea.Cmd {
+	m.log.Debug("Opening list picker", "teamId", request.TeamId)
+
+	entries, err := fetchEntries(m.ctx.Api, request.TeamId)
+	if err != nil {
+		return common.ErrCmd(err)
+	}
+
+	m.Visible = true
+	m.request = request
+	m.entries = entries
+	m.input.Reset()
+	m.filter()
+
+	return m.input.Focus()
+}
+
+func (m Model) IsVisible() bool {
+	return m.Visible
+}
+
+func (m *Model) Close() {
+	m.Visible = false
+	m.input.Blur()
+}
+
+func (m *Model) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m.handleKeys(msg)
+	}
+
+	if !m.Visible {
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+
+	return cmd
+}
+
+type source []entry
+
+func (s source) String(i int) string {
+	return s[i].path
+}
+
+func (s source) Len() int {
+	return len(s)
+}
+
+func (m *Model) filter() {
+	m.cursor = 0
+
+	query := strings.TrimSpace(m.input.Value())
+	if query == "" {
+		m.filtered = m.entries
+		return
+	}
+
+	matches := fuzzy.FindFrom(query, source(m.entries))
+	m.filtered = make([]entry, len(matches))
+	for i, match := range matches {
+		m.filtered[i] = m.entries[match.Index]
+	}
+}
+
+func (m *Model) pick() tea.Cmd {
+	if len(m.filtered) == 0 {
+		return nil
+	}
+
+	picked := m.filtered[m.cursor]
+	m.log.Info("Picked list", "id", picked.list.Id, "path", picked.path)
+	m.Close()
+
+	if m.request.OnPick == nil {
+		return nil
+	}
+
+	return m.request.OnPick(picked.list)
+}
+
+func (m Model) View() string {
+	width := min(maxWidth, m.size.Width-4)
+	if width < 0 {
+		width = 0
+	}
+
+	m.input.Width = width - lipgloss.Width(m.input.Prompt) - 1
+
+	start := 0
+	if m.cursor >= maxVisible {
+		start = m.cursor - maxVisible + 1
+	}
+	end := min(start+maxVisible, len(m.filtered))
+
+	rows := []string{m.request.Title, "", m.input.View(), ""}
+
+	if len(m.filtered) == 0 {
+		rows = append(rows, "No matching lists")
+	}
+
+	for i := start; i < end; i++ {
+		title := m.filtered[i].path
+
+		style := lipgloss.NewStyle()
+		if i == m.cursor {
+			style = m.ctx.Style.TableHighlight
+			title = "> " + title
+		} else {
+			title = "  " + title
+		}
+
+		rows = append(rows, style.MaxWidth(width).Render(title))
+	}
+
+	return m.ctx.Style.Borders.
+		BorderForeground(m.ctx.Theme.BordersColorActive).
+		Width(width).
+		Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+func (m Model) Init() tea.Cmd {
+	m.log.Info("Initializing...")
+	return nil
+}
+
+func (m *Model) SetSize(s common.Size) {
+	m.size = s
+}
+
+func (m Model) Size() common.Size {
+	return m.size
+}
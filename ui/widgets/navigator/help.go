@@ -6,16 +6,26 @@ import (
 )
 
 func (m Model) Help() help.KeyMap {
+	var km help.KeyMap
+
 	switch m.state {
 	case m.componentWorkspacesList.Id():
-		return m.componentWorkspacesList.Help()
+		km = m.componentWorkspacesList.Help()
 	case m.componentSpacesList.Id():
-		return m.componentSpacesList.Help()
+		km = m.componentSpacesList.Help()
 	case m.componentFoldersList.Id():
-		return m.componentFoldersList.Help()
+		km = m.componentFoldersList.Help()
 	case m.componentListsList.Id():
-		return m.componentListsList.Help()
+		km = m.componentListsList.Help()
 	default:
 		return common.NewEmptyHelp()
 	}
+
+	return common.NewHelp(km.FullHelp, km.ShortHelp).
+		With(m.keyMap.Back).
+		With(m.keyMap.ToggleArchived)
+}
+
+func (m Model) Modes() []string {
+	return []string{string(m.state)}
 }
@@ -1,24 +1,37 @@
 package navigator
 
-import tea "github.com/charmbracelet/bubbletea"
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prgrs/clickup/ui/common"
+	"github.com/prgrs/clickup/ui/keybindings"
+)
 
-func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
-	var cmds []tea.Cmd
+type KeyMap struct {
+	Back           key.Binding
+	ToggleArchived key.Binding
+}
 
-	switch keypress := msg.String(); keypress {
-	case "esc":
-		m.log.Info("Received: Go to previous view")
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Back: common.KeyBindingBack,
+		ToggleArchived: key.NewBinding(
+			key.WithKeys("A"),
+			key.WithHelp("A", "toggle archived"),
+		),
+	}
+}
 
-		switch m.state {
-		case m.componentSpacesList.Id():
-			m.state = m.componentWorkspacesList.Id()
-		case m.componentFoldersList.Id():
-			m.state = m.componentSpacesList.Id()
-		case m.componentListsList.Id():
-			m.state = m.componentFoldersList.Id()
-		}
+func (km *KeyMap) Bindings() keybindings.Bindings {
+	return keybindings.Bindings{
+		"back":            &km.Back,
+		"toggle_archived": &km.ToggleArchived,
+	}
+}
 
-		return nil
+func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
+	if action, ok := keybindings.Match(msg, m.keyMap.Bindings()); ok {
+		return m.handleAction(action)
 	}
 
 	var cmd tea.Cmd
@@ -34,5 +47,46 @@ func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
 		cmd = m.componentListsList.Update(msg)
 	}
 
-	return tea.Batch(append(cmds, cmd)...)
+	return cmd
+}
+
+// Handlers returns the handlers of the actions of the navigator and its lists.
+func (m *Model) Handlers() keybindings.Handlers {
+	return keybindings.Handlers{id: m.handleAction}.Merge(
+		m.componentWorkspacesList.Handlers(),
+		m.componentSpacesList.Handlers(),
+		m.componentFoldersList.Handlers(),
+		m.componentListsList.Handlers(),
+	)
+}
+
+// handleAction runs the action of the navigator by its name.
+func (m *Model) handleAction(action string) tea.Cmd {
+	switch action {
+	case "back":
+		m.log.Info("Received: Go to previous view")
+
+		switch m.state {
+		case m.componentSpacesList.Id():
+			m.state = m.componentWorkspacesList.Id()
+		case m.componentFoldersList.Id():
+			m.state = m.componentSpacesList.Id()
+		case m.componentListsList.Id():
+			m.state = m.componentFoldersList.Id()
+		}
+
+	case "toggle_archived":
+		m.showArchived = !m.showArchived
+		m.log.Info("Toggle archived", "show", m.showArchived)
+
+		if err := m.componentFoldersList.SetShowArchived(m.showArchived); err != nil {
+			return common.ErrCmd(err)
+		}
+
+		if err := m.componentListsList.SetShowArchived(m.showArchived); err != nil {
+			return common.ErrCmd(err)
+		}
+	}
+
+	return nil
 }
@@ -7,6 +7,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/log"
+	"github.com/prgrs/clickup/api"
 	"github.com/prgrs/clickup/pkg/clickup"
 	"github.com/prgrs/clickup/ui/common"
 	folderslist "github.com/prgrs/clickup/ui/components/folders-list"
@@ -30,6 +31,9 @@ type Model struct {
 	state       common.Id
 	spinner     spinner.Model
 	showSpinner bool
+	keyMap      KeyMap
+
+	showArchived bool
 
 	componentWorkspacesList *workspaceslist.Model
 	componentSpacesList     *spaceslist.Model
@@ -80,6 +84,14 @@ func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
 		componentFoldersList    = folderslist.InitialModel(ctx, log)
 		componentSpacesList     = spaceslist.InitialModel(ctx, log)
 		cpomponentListsList     = listslist.InitialModel(ctx, log)
+		keyMap                  = DefaultKeyMap()
+	)
+
+	ctx.KeyBindings.Register(id, keyMap.Bindings(),
+		string(componentWorkspacesList.Id()),
+		string(componentSpacesList.Id()),
+		string(componentFoldersList.Id()),
+		string(cpomponentListsList.Id()),
 	)
 
 	return Model{
@@ -90,6 +102,7 @@ func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
 		Hidden:    false,
 		log:       log,
 		ifBorders: true,
+		keyMap:    keyMap,
 
 		componentWorkspacesList: &componentWorkspacesList,
 		componentFoldersList:    &componentFoldersList,
@@ -130,6 +143,7 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 	case workspaceslist.WorkspacePreviewMsg:
 		id := string(msg)
 		m.log.Debug("Received: workspaceslist.WorkspacePreviewMsg", "id", id)
+		m.ctx.Api.Prefetch(api.CacheNamespaceSpaces, id)
 		cmds = append(cmds, WorkspacePreviewCmd(id))
 
 	case spinner.TickMsg:
@@ -166,6 +180,8 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 	case spaceslist.SpacePreviewMsg:
 		id := string(msg)
 		m.log.Debug("Received: spaceslist.SpacePreviewMsg", "id", id)
+		m.ctx.Api.Prefetch(api.CacheNamespaceFolders, id)
+		m.ctx.Api.Prefetch(api.CacheNamespaceListsSpace, id)
 		cmds = append(cmds, SpacePreviewCmd(id))
 
 	case LoadingFoldersFromSpaceMsg:
@@ -191,6 +207,11 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 	case folderslist.FolderPreviewMsg:
 		id := string(msg)
 		m.log.Debug("Received: folderslist.FolderPreviewMsg", "id", id)
+		if spaceId, ok := api.ParseFolderlessFolderId(id); ok {
+			m.ctx.Api.Prefetch(api.CacheNamespaceListsSpace, spaceId)
+		} else {
+			m.ctx.Api.Prefetch(api.CacheNamespaceListsFolder, id)
+		}
 		cmds = append(cmds, FolderPreviewCmd(id))
 
 	case folderslist.FolderSelectedMsg:
@@ -253,11 +274,7 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		errgroup.Go(func() error {
 			id := m.componentSpacesList.Selected.Id
 			if id != "" {
-				t, err := m.ctx.Api.GetFolders(id)
-				if err != nil {
-					return err
-				}
-				m.componentFoldersList.SetList(t)
+				return m.componentFoldersList.SpaceChanged(id)
 			}
 			return nil
 		})
@@ -0,0 +1,13 @@
+package palette
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prgrs/clickup/ui/keybindings"
+)
+
+// RunActionMsg asks to run the action by the handler of its component.
+type RunActionMsg keybindings.Action
+
+func RunActionCmd(action keybindings.Action) tea.Cmd {
+	return func() tea.Msg { return RunActionMsg(action) }
+}
@@ -0,0 +1,82 @@
+package palette
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prgrs/clickup/ui/keybindings"
+)
+
+type KeyMap struct {
+	Run   key.Binding
+	Close key.Binding
+	Up    key.Binding
+	Down  key.Binding
+}
+
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Run: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "run action"),
+		),
+		Close: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "close palette"),
+		),
+		Up: key.NewBinding(
+			key.WithKeys("up", "ctrl+k"),
+			key.WithHelp("up, ctrl+k", "up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "ctrl+j"),
+			key.WithHelp("down, ctrl+j", "down"),
+		),
+	}
+}
+
+func (km *KeyMap) Bindings() keybindings.Bindings {
+	return keybindings.Bindings{
+		"run":   &km.Run,
+		"close": &km.Close,
+		"up":    &km.Up,
+		"down":  &km.Down,
+	}
+}
+
+func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, m.keyMap.Close):
+		m.Close()
+		return nil
+
+	case key.Matches(msg, m.keyMap.Up):
+		if m.cursor > 0 {
+			m.cursor--
+		}
+		return nil
+
+	case key.Matches(msg, m.keyMap.Down):
+		if m.cursor < len(m.filtered)-1 {
+			m.cursor++
+		}
+		return nil
+
+	case key.Matches(msg, m.keyMap.Run):
+		if len(m.filtered) == 0 {
+			return nil
+		}
+		action := m.filtered[m.cursor]
+		m.log.Info("Running action", "action", action.Id())
+		m.Close()
+		return RunActionCmd(action)
+	}
+
+	var cmd tea.Cmd
+	query := m.input.Value()
+	m.input, cmd = m.input.Update(msg)
+	if m.input.Value() != query {
+		m.filter()
+	}
+
+	return cmd
+}
@@ -0,0 +1,178 @@
+package palette
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/prgrs/clickup/ui/common"
+	"github.com/prgrs/clickup/ui/context"
+	"github.com/prgrs/clickup/ui/keybindings"
+	"github.com/sahilm/fuzzy"
+)
+
+const (
+	id = "palette"
+
+	maxWidth   = 80
+	maxVisible = 12
+)
+
+type Model struct {
+	id       common.Id
+	ctx      *context.UserContext
+	log      *log.Logger
+	size     common.Size
+	keyMap   KeyMap
+	input    textinput.Model
+	actions  []keybindings.Action
+	filtered []keybindings.Action
+	cursor   int
+
+	Visible bool
+}
+
+func (m Model) Id() common.Id {
+	return m.id
+}
+
+func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
+	log := common.NewLogger(logger, common.ResourceTypeRegistry.WIDGET, id)
+
+	keyMap := DefaultKeyMap()
+	ctx.KeyBindings.Register(id, keyMap.Bindings(), id)
+
+	input := textinput.New()
+	input.Prompt = ": "
+	input.Placeholder = "Type to search actions..."
+
+	return Model{
+		id:      id,
+		ctx:     ctx,
+		log:     log,
+		keyMap:  keyMap,
+		input:   input,
+		Visible: false,
+	}
+}
+
+// Open shows the palette with the actions registered under the given modes
+// which can be run by the handlers.
+func (m *Model) Open(modes []string, h keybindings.Handlers) tea.Cmd {
+	m.log.Debug("Opening palette", "modes", modes)
+	m.Visible = true
+	m.actions = m.ctx.KeyBindings.Actions(h, modes...)
+	m.input.Reset()
+	m.filter()
+
+	return m.input.Focus()
+}
+
+func (m Model) IsVisible() bool {
+	return m.Visible
+}
+
+func (m *Model) Close() {
+	m.Visible = false
+	m.input.Blur()
+}
+
+func (m *Model) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m.handleKeys(msg)
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+
+	return cmd
+}
+
+type source []keybindings.Action
+
+func (s source) String(i int) string {
+	return s[i].Title() + " " + s[i].Component + " " + s[i].Name
+}
+
+func (s source) Len() int {
+	return len(s)
+}
+
+func (m *Model) filter() {
+	m.cursor = 0
+
+	query := strings.TrimSpace(m.input.Value())
+	if query == "" {
+		m.filtered = m.actions
+		return
+	}
+
+	matches := fuzzy.FindFrom(query, source(m.actions))
+	m.filtered = make([]keybindings.Action, len(matches))
+	for i, match := range matches {
+		m.filtered[i] = m.actions[match.Index]
+	}
+}
+
+func (m Model) View() string {
+	width := min(maxWidth, m.size.Width-4)
+	if width < 0 {
+		width = 0
+	}
+
+	m.input.Width = width - lipgloss.Width(m.input.Prompt) - 1
+
+	start := 0
+	if m.cursor >= maxVisible {
+		start = m.cursor - maxVisible + 1
+	}
+	end := min(start+maxVisible, len(m.filtered))
+
+	rows := []string{m.input.View(), ""}
+
+	if len(m.filtered) == 0 {
+		rows = append(rows, "No matching actions")
+	}
+
+	for i := start; i < end; i++ {
+		action := m.filtered[i]
+		keys := m.ctx.Style.Help.ShortKey.Render(action.Binding.Help().Key)
+		title := action.Title()
+
+		style := lipgloss.NewStyle()
+		if i == m.cursor {
+			style = m.ctx.Style.TableHighlight
+			title = "> " + title
+		} else {
+			title = "  " + title
+		}
+
+		gap := width - lipgloss.Width(title) - lipgloss.Width(keys)
+		if gap < 1 {
+			gap = 1
+		}
+
+		rows = append(rows, style.Render(title)+strings.Repeat(" ", gap)+keys)
+	}
+
+	return m.ctx.Style.Borders.
+		BorderForeground(m.ctx.Theme.BordersColorActive).
+		Width(width).
+		Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+func (m Model) Init() tea.Cmd {
+	m.log.Info("Initializing...")
+	return nil
+}
+
+func (m *Model) SetSize(s common.Size) {
+	m.size = s
+}
+
+func (m Model) Size() common.Size {
+	return m.size
+}
@@ -0,0 +1,18 @@
+package prompt
+
+import (
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/prgrs/clickup/ui/common"
+)
+
+func (m Model) Help() help.KeyMap {
+	return common.NewHelp(
+		func() [][]key.Binding {
+			return [][]key.Binding{{m.keyMap.Submit, m.keyMap.Cancel}}
+		},
+		func() []key.Binding {
+			return []key.Binding{m.keyMap.Submit, m.keyMap.Cancel}
+		},
+	)
+}
@@ -0,0 +1,149 @@
+package prompt
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/prgrs/clickup/ui/common"
+	"github.com/prgrs/clickup/ui/context"
+)
+
+const (
+	id = "prompt"
+
+	maxWidth = 60
+)
+
+// Model is a dialog asking for a value or a confirmation requested by
+// common.PromptMsg.
+type Model struct {
+	id      common.Id
+	ctx     *context.UserContext
+	log     *log.Logger
+	size    common.Size
+	keyMap  KeyMap
+	input   textinput.Model
+	request common.PromptMsg
+
+	Visible bool
+}
+
+func (m Model) Id() common.Id {
+	return m.id
+}
+
+func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
+	log := common.NewLogger(logger, common.ResourceTypeRegistry.WIDGET, id)
+
+	keyMap := DefaultKeyMap()
+	ctx.KeyBindings.Register(id, keyMap.Bindings(), id)
+
+	input := textinput.New()
+	input.Prompt = "> "
+
+	return Model{
+		id:      id,
+		ctx:     ctx,
+		log:     log,
+		keyMap:  keyMap,
+		input:   input,
+		Visible: false,
+	}
+}
+
+func (m *Model) Open(request common.PromptMsg) tea.Cmd {
+	m.log.Debug("Opening prompt", "title", request.Title)
+	m.Visible = true
+	m.request = request
+
+	if !request.Input {
+		m.input.Blur()
+		return nil
+	}
+
+	m.input.SetValue(request.Value)
+	m.input.CursorEnd()
+
+	return m.input.Focus()
+}
+
+func (m Model) IsVisible() bool {
+	return m.Visible
+}
+
+func (m *Model) Close() {
+	m.Visible = false
+	m.input.Blur()
+}
+
+func (m *Model) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m.handleKeys(msg)
+	}
+
+	if !m.Visible || !m.request.Input {
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+
+	return cmd
+}
+
+// submit closes the dialog and calls the callback of the request. Empty
+// values are not submitted.
+func (m *Model) submit() tea.Cmd {
+	value := strings.TrimSpace(m.input.Value())
+	if m.request.Input && value == "" {
+		return nil
+	}
+
+	m.Close()
+
+	if m.request.OnSubmit == nil {
+		return nil
+	}
+
+	return m.request.OnSubmit(value)
+}
+
+func (m Model) View() string {
+	width := min(maxWidth, m.size.Width-4)
+	if width < 0 {
+		width = 0
+	}
+
+	rows := []string{m.request.Title, ""}
+
+	if m.request.Input {
+		m.input.Width = width - lipgloss.Width(m.input.Prompt) - 1
+		rows = append(rows, m.input.View())
+	} else {
+		rows = append(rows, m.ctx.Style.Help.ShortDesc.Render(
+			m.keyMap.Submit.Help().Key+" to confirm, "+m.keyMap.Cancel.Help().Key+" to cancel",
+		))
+	}
+
+	return m.ctx.Style.Borders.
+		BorderForeground(m.ctx.Theme.BordersColorActive).
+		Width(width).
+		Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+func (m Model) Init() tea.Cmd {
+	m.log.Info("Initializing...")
+	return nil
+}
+
+func (m *Model) SetSize(s common.Size) {
+	m.size = s
+}
+
+func (m Model) Size() common.Size {
+	return m.size
+}
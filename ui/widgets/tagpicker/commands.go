@@ -0,0 +1,19 @@
+package tagpicker
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prgrs/clickup/pkg/clickup"
+)
+
+// OpenMsg opens the picker with tags of the spaces of the tasks.
+type OpenMsg struct {
+	Title string
+	Tasks []clickup.Task
+	// OnApply is called with tags to add to and names of tags to remove from
+	// the tasks. It is not called if the picker is closed.
+	OnApply func(add []clickup.TaskTag, remove []string) tea.Cmd
+}
+
+func OpenCmd(msg OpenMsg) tea.Cmd {
+	return func() tea.Msg { return msg }
+}
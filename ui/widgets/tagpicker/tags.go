@@ -0,0 +1,128 @@
+package tagpicker
+
+import (
+	"hash/fnv"
+	"slices"
+	"strings"
+
+	"github.com/prgrs/clickup/api"
+	"github.com/prgrs/clickup/pkg/clickup"
+)
+
+// tagColors are assigned to created tags by their name.
+var tagColors = []string{
+	"#e50000", "#ff7800", "#f9d900", "#2ecd6f", "#0ab4ff",
+	"#4169e1", "#7b68ee", "#ee5e99", "#b660e0", "#667684",
+}
+
+// state tells how many of the tasks have the tag.
+type state int
+
+const (
+	stateNone state = iota
+	stateSome
+	stateAll
+)
+
+type entry struct {
+	tag     clickup.TaskTag
+	initial state
+	state   state
+}
+
+// toggle switches the tag between added to and removed from all tasks.
+// Tags that some of the tasks have can be left unchanged too.
+func (e *entry) toggle() {
+	switch e.state {
+	case stateSome:
+		e.state = stateAll
+	case stateAll:
+		e.state = stateNone
+	default:
+		e.state = stateAll
+		if e.initial == stateSome {
+			e.state = stateSome
+		}
+	}
+}
+
+func (e entry) mark() string {
+	switch e.state {
+	case stateAll:
+		return "[x]"
+	case stateSome:
+		return "[-]"
+	default:
+		return "[ ]"
+	}
+}
+
+// spaceIds returns spaces of the tasks in order of their first task.
+func spaceIds(tasks []clickup.Task) []string {
+	ids := []string{}
+	for _, task := range tasks {
+		if task.Space.Id != "" && !slices.Contains(ids, task.Space.Id) {
+			ids = append(ids, task.Space.Id)
+		}
+	}
+
+	return ids
+}
+
+// fetchEntries returns tags of the spaces, tags with the same name merged,
+// with the number of the tasks having them.
+func fetchEntries(a *api.Api, spaceIds []string, tasks []clickup.Task) ([]entry, error) {
+	entries := []entry{}
+	add := func(tag clickup.TaskTag) {
+		if slices.ContainsFunc(entries, func(e entry) bool { return strings.EqualFold(e.tag.Name, tag.Name) }) {
+			return
+		}
+
+		s := stateOf(tag.Name, tasks)
+		entries = append(entries, entry{tag: tag, initial: s, state: s})
+	}
+
+	for _, spaceId := range spaceIds {
+		tags, err := a.GetSpaceTags(spaceId)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tag := range tags {
+			add(tag)
+		}
+	}
+
+	for _, task := range tasks {
+		for _, tag := range task.Tags {
+			add(tag)
+		}
+	}
+
+	return entries, nil
+}
+
+func stateOf(name string, tasks []clickup.Task) state {
+	count := 0
+	for _, task := range tasks {
+		if slices.ContainsFunc(task.Tags, func(t clickup.TaskTag) bool { return strings.EqualFold(t.Name, name) }) {
+			count++
+		}
+	}
+
+	switch count {
+	case 0:
+		return stateNone
+	case len(tasks):
+		return stateAll
+	default:
+		return stateSome
+	}
+}
+
+func tagColor(name string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+
+	return tagColors[h.Sum32()%uint32(len(tagColors))]
+}
@@ -0,0 +1,304 @@
+package tagpicker
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/prgrs/clickup/pkg/clickup"
+	"github.com/prgrs/clickup/ui/common"
+	"github.com/prgrs/clickup/ui/context"
+	"github.com/sahilm/fuzzy"
+)
+
+const (
+	id = "tag-picker"
+
+	maxWidth   = 60
+	maxVisible = 12
+)
+
+// Model is a dialog adding and removing tags of the spaces to tasks. Tags
+// that do not exist yet are created in the spaces.
+type Model struct {
+	id       common.Id
+	ctx      *context.UserContext
+	log      *log.Logger
+	size     common.Size
+	keyMap   KeyMap
+	input    textinput.Model
+	request  OpenMsg
+	spaceIds []string
+	entries  []entry
+	// filtered holds indexes of entries matching the query
+	filtered []int
+	cursor   int
+
+	Visible bool
+}
+
+func (m Model) Id() common.Id {
+	return m.id
+}
+
+func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
+	log := common.NewLogger(logger, common.ResourceTypeRegistry.WIDGET, id)
+
+	keyMap := DefaultKeyMap()
+	ctx.KeyBindings.Register(id, keyMap.Bindings(), id)
+
+	input := textinput.New()
+	input.Prompt = "> "
+	input.Placeholder = "Type to search or create tags..."
+
+	return Model{
+		id:      id,
+		ctx:     ctx,
+		log:     log,
+		keyMap:  keyMap,
+		input:   input,
+		Visible: false,
+	}
+}
+
+func (m *Model) Open(request OpenMsg) tea.Cmd {
+	spaceIds := spaceIds(request.Tasks)
+	m.log.Debug("Opening tag picker", "spaceIds", spaceIds)
+
+	entries, err := fetchEntries(m.ctx.Api, spaceIds, request.Tasks)
+	if err != nil {
+		return common.ErrCmd(err)
+	}
+
+	m.Visible = true
+	m.request = request
+	m.spaceIds = spaceIds
+	m.entries = entries
+	m.input.Reset()
+	m.filter()
+
+	return m.input.Focus()
+}
+
+func (m Model) IsVisible() bool {
+	return m.Visible
+}
+
+func (m *Model) Close() {
+	m.Visible = false
+	m.input.Blur()
+}
+
+func (m *Model) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m.handleKeys(msg)
+	}
+
+	if !m.Visible {
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+
+	return cmd
+}
+
+type source []entry
+
+func (s source) String(i int) string {
+	return s[i].tag.Name
+}
+
+func (s source) Len() int {
+	return len(s)
+}
+
+func (m *Model) query() string {
+	return strings.ToLower(strings.TrimSpace(m.input.Value()))
+}
+
+func (m *Model) filter() {
+	m.cursor = 0
+
+	query := m.query()
+	if query == "" {
+		m.filtered = make([]int, len(m.entries))
+		for i := range m.entries {
+			m.filtered[i] = i
+		}
+		return
+	}
+
+	matches := fuzzy.FindFrom(query, source(m.entries))
+	m.filtered = make([]int, len(matches))
+	for i, match := range matches {
+		m.filtered[i] = match.Index
+	}
+}
+
+// creating reports whether the query names a tag that does not exist, which
+// is offered to be created in the first row.
+func (m *Model) creating() bool {
+	query := m.query()
+	if query == "" || len(m.spaceIds) == 0 {
+		return false
+	}
+
+	return !slices.ContainsFunc(m.entries, func(e entry) bool {
+		return strings.EqualFold(e.tag.Name, query)
+	})
+}
+
+func (m *Model) rows() int {
+	if m.creating() {
+		return len(m.filtered) + 1
+	}
+
+	return len(m.filtered)
+}
+
+// entryAt returns the entry shown in the row or nil for the row creating a
+// tag.
+func (m *Model) entryAt(row int) *entry {
+	if m.creating() {
+		row--
+	}
+
+	if row < 0 || row >= len(m.filtered) {
+		return nil
+	}
+
+	return &m.entries[m.filtered[row]]
+}
+
+func (m *Model) toggle() tea.Cmd {
+	if m.creating() && m.cursor == 0 {
+		return m.createTag()
+	}
+
+	if e := m.entryAt(m.cursor); e != nil {
+		e.toggle()
+	}
+
+	return nil
+}
+
+// createTag creates the tag named by the query in all spaces of the tasks
+// and marks it to be added.
+func (m *Model) createTag() tea.Cmd {
+	name := m.query()
+	color := tagColor(name)
+	tag := clickup.TaskTag{
+		Name:   name,
+		Tag_fg: color,
+		Tag_bg: color,
+	}
+
+	for _, spaceId := range m.spaceIds {
+		m.log.Info("Creating tag", "spaceId", spaceId, "name", name)
+
+		var err error
+		if tag, err = m.ctx.Api.CreateSpaceTag(spaceId, tag); err != nil {
+			return common.ErrCmd(err)
+		}
+	}
+
+	m.entries = append(m.entries, entry{tag: tag, initial: stateNone, state: stateAll})
+	m.input.Reset()
+	m.filter()
+	m.cursor = len(m.filtered) - 1
+
+	return nil
+}
+
+func (m *Model) apply() tea.Cmd {
+	add := []clickup.TaskTag{}
+	remove := []string{}
+
+	for _, e := range m.entries {
+		if e.state == e.initial {
+			continue
+		}
+
+		switch e.state {
+		case stateAll:
+			add = append(add, e.tag)
+		case stateNone:
+			remove = append(remove, e.tag.Name)
+		}
+	}
+
+	m.log.Info("Applying tags", "add", len(add), "remove", len(remove))
+	m.Close()
+
+	if m.request.OnApply == nil || len(add)+len(remove) == 0 {
+		return nil
+	}
+
+	return m.request.OnApply(add, remove)
+}
+
+func (m Model) View() string {
+	width := min(maxWidth, m.size.Width-4)
+	if width < 0 {
+		width = 0
+	}
+
+	m.input.Width = width - lipgloss.Width(m.input.Prompt) - 1
+
+	start := 0
+	if m.cursor >= maxVisible {
+		start = m.cursor - maxVisible + 1
+	}
+	end := min(start+maxVisible, m.rows())
+
+	rows := []string{m.request.Title, "", m.input.View(), ""}
+
+	if m.rows() == 0 {
+		rows = append(rows, "No matching tags")
+	}
+
+	for i := start; i < end; i++ {
+		prefix := "  "
+		style := lipgloss.NewStyle()
+		if i == m.cursor {
+			prefix = "> "
+			style = m.ctx.Style.TableHighlight
+		}
+
+		e := m.entryAt(i)
+		if e == nil {
+			rows = append(rows, style.MaxWidth(width).Render(
+				fmt.Sprintf("%s+ create tag %q", prefix, m.query()),
+			))
+			continue
+		}
+
+		row := style.Render(prefix+e.mark()) + " " + common.TagChip(e.tag)
+		rows = append(rows, lipgloss.NewStyle().MaxWidth(width).Render(row))
+	}
+
+	return m.ctx.Style.Borders.
+		BorderForeground(m.ctx.Theme.BordersColorActive).
+		Width(width).
+		Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+func (m Model) Init() tea.Cmd {
+	m.log.Info("Initializing...")
+	return nil
+}
+
+func (m *Model) SetSize(s common.Size) {
+	m.size = s
+}
+
+func (m Model) Size() common.Size {
+	return m.size
+}
@@ -0,0 +1,438 @@
+package tasks
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/prgrs/clickup/api"
+	"github.com/prgrs/clickup/pkg/clickup"
+	"github.com/prgrs/clickup/pkg/nldate"
+	"github.com/prgrs/clickup/ui/common"
+	"github.com/prgrs/clickup/ui/widgets/tagpicker"
+)
+
+const (
+	bulkSummaryMaxRows = 8
+	bulkProgressWidth  = 30
+
+	dateLayout     = "2006-01-02"
+	dateTimeLayout = "2006-01-02 15:04"
+)
+
+// bulkEdit tracks tasks updated in bulk. The summary is shown until it is
+// dismissed.
+type bulkEdit struct {
+	title   string
+	total   int
+	updates <-chan api.TaskUpdateResult
+	results []api.TaskUpdateResult
+}
+
+func (b bulkEdit) done() bool {
+	return len(b.results) == b.total
+}
+
+func (b bulkEdit) failed() int {
+	failed := 0
+	for _, r := range b.results {
+		if r.Err != nil {
+			failed++
+		}
+	}
+
+	return failed
+}
+
+// startBulkEdit applies the edit to copies of the tasks and updates them in
+// the background.
+func (m *Model) startBulkEdit(title string, tasks []clickup.Task, field clickup.TaskField, edit func(task *clickup.Task)) tea.Cmd {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	edited := make([]clickup.Task, len(tasks))
+	for i, task := range tasks {
+		task.Assignees = slices.Clone(task.Assignees)
+		task.Tags = slices.Clone(task.Tags)
+		edit(&task)
+		edited[i] = task
+	}
+
+	m.log.Info("Starting bulk edit", "title", title, "count", len(edited))
+
+	updates := m.ctx.Api.UpdateTasks(edited, field)
+	m.bulk = &bulkEdit{
+		title:   title,
+		total:   len(edited),
+		updates: updates,
+	}
+
+	return WaitForBulkUpdateCmd(updates)
+}
+
+func (m *Model) handleBulkUpdate(msg BulkUpdateMsg) tea.Cmd {
+	if m.bulk == nil || m.bulk.updates != msg.updates {
+		return nil
+	}
+
+	if msg.Done {
+		m.log.Info("Bulk edit finished", "title", m.bulk.title, "failed", m.bulk.failed())
+		return m.reloadTasks()
+	}
+
+	m.bulk.results = append(m.bulk.results, msg.Result)
+
+	selected := m.componenetTasksSidebar.SelectedTask
+	if msg.Result.Err == nil && selected.Id == msg.Result.Task.Id {
+		if err := m.componenetTasksSidebar.SetTask(msg.Result.Task); err != nil {
+			return common.ErrCmd(err)
+		}
+	}
+
+	return WaitForBulkUpdateCmd(msg.updates)
+}
+
+func (m Model) viewBulk(width int) string {
+	b := m.bulk
+	innerWidth := max(width-2, 0)
+
+	rows := []string{}
+	if !b.done() {
+		rows = append(rows, fmt.Sprintf("%s %s %d/%d",
+			b.title, common.ProgressBar(min(bulkProgressWidth, innerWidth), len(b.results), b.total),
+			len(b.results), b.total,
+		))
+	} else {
+		failed := b.failed()
+		rows = append(rows, fmt.Sprintf("%s: %d succeeded, %d failed (%s to dismiss)",
+			b.title, b.total-failed, failed, m.keyMap.LostFocus.Help().Key,
+		))
+	}
+
+	// failures go first as they are the reason to read the summary
+	results := slices.Clone(b.results)
+	slices.SortStableFunc(results, func(a, b api.TaskUpdateResult) int {
+		switch {
+		case a.Err != nil && b.Err == nil:
+			return -1
+		case a.Err == nil && b.Err != nil:
+			return 1
+		}
+		return 0
+	})
+
+	for i, r := range results {
+		if i == bulkSummaryMaxRows {
+			rows = append(rows, fmt.Sprintf("... and %d more", len(results)-i))
+			break
+		}
+
+		row := "✓ " + r.Task.Name
+		if r.Err != nil {
+			row = "✗ " + r.Task.Name + ": " + r.Err.Error()
+		}
+		rows = append(rows, lipgloss.NewStyle().MaxWidth(innerWidth).Render(row))
+	}
+
+	return m.ctx.Style.Borders.
+		BorderForeground(m.ctx.Theme.BordersColorEditMode).
+		Width(innerWidth).
+		Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+// teamIdOf returns the workspace of the tasks falling back to the default one.
+func (m *Model) teamIdOf(tasks []clickup.Task) string {
+	if len(tasks) > 0 && tasks[0].TeamId != "" {
+		return tasks[0].TeamId
+	}
+
+	return m.ctx.Config.DefaultWorkspace
+}
+
+// tasksLabel names the task if there is only one or counts them otherwise.
+func tasksLabel(tasks []clickup.Task) string {
+	if len(tasks) == 1 {
+		return strconv.Quote(tasks[0].Name)
+	}
+
+	return fmt.Sprintf("%d tasks", len(tasks))
+}
+
+func (m *Model) bulkEditStatus() tea.Cmd {
+	tasks := m.targetTasks()
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	title := "Status of " + tasksLabel(tasks)
+
+	return common.InputCmd("Set "+strings.ToLower(title), tasks[0].Status.Status, func(value string) tea.Cmd {
+		return m.startBulkEdit(title, tasks, clickup.TaskFieldStatus, func(task *clickup.Task) {
+			task.Status.Status = value
+		})
+	})
+}
+
+func (m *Model) bulkEditPriority() tea.Cmd {
+	tasks := m.targetTasks()
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	title := "Priority of " + tasksLabel(tasks)
+
+	var onSubmit func(value string) tea.Cmd
+	onSubmit = func(value string) tea.Cmd {
+		priority, ok := clickup.ParsePriority(value)
+		if !ok {
+			return common.InputCmd(
+				fmt.Sprintf("Unknown priority %q, use one of: %s, none", value, strings.Join(clickup.Priorities, ", ")),
+				value, onSubmit,
+			)
+		}
+
+		return m.startBulkEdit(title, tasks, clickup.TaskFieldPriority, func(task *clickup.Task) {
+			task.Priority = priority
+		})
+	}
+
+	return common.InputCmd(
+		fmt.Sprintf("Set %s (%s, none)", strings.ToLower(title), strings.Join(clickup.Priorities, ", ")),
+		tasks[0].Priority.Priority, onSubmit,
+	)
+}
+
+func (m *Model) bulkEditAssignees() tea.Cmd {
+	tasks := m.targetTasks()
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	members, err := m.ctx.Api.GetMembers(m.teamIdOf(tasks))
+	if err != nil {
+		return common.ErrCmd(err)
+	}
+
+	title := "Assignees of " + tasksLabel(tasks)
+
+	var onSubmit func(value string) tea.Cmd
+	onSubmit = func(value string) tea.Cmd {
+		add, rem := parseChanges(value)
+
+		users := []clickup.User{}
+		for _, name := range slices.Concat(add, rem) {
+			i := slices.IndexFunc(members, func(u clickup.User) bool {
+				return strings.EqualFold(u.Username, name) || strings.EqualFold(u.Email, name)
+			})
+			if i < 0 {
+				return common.InputCmd(fmt.Sprintf("Unknown user %q", name), value, onSubmit)
+			}
+			users = append(users, members[i])
+		}
+
+		return m.startBulkEdit(title, tasks, clickup.TaskFieldAssignees, func(task *clickup.Task) {
+			for i, user := range users {
+				isUser := func(a clickup.Assignee) bool { return a.Id == uint(user.Id) }
+				task.Assignees = slices.DeleteFunc(task.Assignees, isUser)
+
+				if i < len(add) {
+					task.Assignees = append(task.Assignees, clickup.Assignee{
+						Id:       uint(user.Id),
+						Username: user.Username,
+						Email:    user.Email,
+						Color:    user.Color,
+						Initials: user.Initials,
+					})
+				}
+			}
+		})
+	}
+
+	return common.InputCmd("Add (+name) or remove (-name) assignees, separated by commas", "", onSubmit)
+}
+
+func (m *Model) bulkEditTags() tea.Cmd {
+	tasks := m.targetTasks()
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	title := "Tags of " + tasksLabel(tasks)
+
+	onApply := func(add []clickup.TaskTag, remove []string) tea.Cmd {
+		return m.startBulkEdit(title, tasks, clickup.TaskFieldTags, func(task *clickup.Task) {
+			task.Tags = slices.DeleteFunc(task.Tags, func(t clickup.TaskTag) bool {
+				return slices.Contains(remove, t.Name)
+			})
+
+			for _, tag := range add {
+				if !slices.ContainsFunc(task.Tags, func(t clickup.TaskTag) bool { return t.Name == tag.Name }) {
+					task.Tags = append(task.Tags, tag)
+				}
+			}
+		})
+	}
+
+	return tagpicker.OpenCmd(tagpicker.OpenMsg{
+		Title:   title,
+		Tasks:   tasks,
+		OnApply: onApply,
+	})
+}
+
+func (m *Model) bulkEditDueDate() tea.Cmd {
+	return m.bulkEditDate("Due date", clickup.TaskFieldDueDate, clickup.Task.GetDueDate,
+		func(task *clickup.Task, date interface{}) { task.Duedate = date },
+	)
+}
+
+func (m *Model) bulkEditStartDate() tea.Cmd {
+	return m.bulkEditDate("Start date", clickup.TaskFieldStartDate, clickup.Task.GetStartDate,
+		func(task *clickup.Task, date interface{}) { task.Startdate = date },
+	)
+}
+
+// bulkEditDate asks for a date written in natural language, see
+// nldate.Parse. An empty value clears the date.
+func (m *Model) bulkEditDate(
+	label string,
+	field clickup.TaskField,
+	get func(clickup.Task) (time.Time, bool),
+	set func(task *clickup.Task, date interface{}),
+) tea.Cmd {
+	tasks := m.targetTasks()
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	title := label + " of " + tasksLabel(tasks)
+
+	value := ""
+	if date, ok := get(tasks[0]); ok {
+		value = formatDate(date)
+	}
+
+	var onSubmit func(value string) tea.Cmd
+	onSubmit = func(value string) tea.Cmd {
+		var date interface{}
+
+		if strings.TrimSpace(value) != "" {
+			t, _, err := nldate.Parse(value, time.Now())
+			if err != nil {
+				return common.InputCmd(
+					fmt.Sprintf("Invalid %s %q: %s", strings.ToLower(label), value, err),
+					value, onSubmit,
+				)
+			}
+			date = strconv.FormatInt(t.UnixMilli(), 10)
+		}
+
+		return m.startBulkEdit(title, tasks, field, func(task *clickup.Task) {
+			set(task, date)
+		})
+	}
+
+	return common.InputCmd(
+		"Set "+strings.ToLower(title)+" (e.g. tomorrow 5pm, next fri, +3d; empty clears)",
+		value, onSubmit,
+	)
+}
+
+func (m *Model) bulkEditTimeEstimate() tea.Cmd {
+	tasks := m.targetTasks()
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	title := "Time estimate of " + tasksLabel(tasks)
+
+	value := ""
+	if estimate, ok := tasks[0].GetTimeEstimate(); ok && estimate > 0 {
+		value = nldate.FormatDuration(estimate)
+	}
+
+	var onSubmit func(value string) tea.Cmd
+	onSubmit = func(value string) tea.Cmd {
+		var estimate interface{}
+
+		if strings.TrimSpace(value) != "" {
+			d, err := nldate.ParseDuration(value)
+			if err != nil {
+				return common.InputCmd(
+					fmt.Sprintf("Invalid time estimate %q: %s", value, err),
+					value, onSubmit,
+				)
+			}
+			estimate = d.Milliseconds()
+		}
+
+		return m.startBulkEdit(title, tasks, clickup.TaskFieldTimeEstimate, func(task *clickup.Task) {
+			task.Timeestimate = estimate
+		})
+	}
+
+	return common.InputCmd("Set "+strings.ToLower(title)+" (e.g. 2h30m; empty clears)", value, onSubmit)
+}
+
+// bulkArchive archives or unarchives selected tasks, or the highlighted one if
+// none is selected, in the background. The edits can be undone and failures
+// are listed in the summary.
+func (m *Model) bulkArchive() tea.Cmd {
+	tasks := m.targetTasks()
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	// tasks are archived unless all of them already are
+	archived := slices.ContainsFunc(tasks, func(t clickup.Task) bool { return !t.Archived })
+
+	title := "Archive " + tasksLabel(tasks)
+	if !archived {
+		title = "Unarchive " + tasksLabel(tasks)
+	}
+
+	return common.ConfirmCmd(title+"?", func() tea.Cmd {
+		return m.startBulkEdit(title, tasks, clickup.TaskFieldArchived, func(task *clickup.Task) {
+			task.Archived = archived
+		})
+	})
+}
+
+// parseChanges splits comma separated names into added and removed ones.
+// Names prefixed with "-" are removed, the rest is added.
+func parseChanges(value string) (add []string, rem []string) {
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+
+		switch {
+		case strings.HasPrefix(name, "-"):
+			if name = strings.TrimSpace(name[1:]); name != "" {
+				rem = append(rem, name)
+			}
+		case strings.HasPrefix(name, "+"):
+			if name = strings.TrimSpace(name[1:]); name != "" {
+				add = append(add, name)
+			}
+		case name != "":
+			add = append(add, name)
+		}
+	}
+
+	return add, rem
+}
+
+// formatDate formats the date so it can be parsed back. The time of day is
+// left out for dates at midnight.
+func formatDate(t time.Time) string {
+	t = t.Local()
+	if t.Equal(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())) {
+		return t.Format(dateLayout)
+	}
+
+	return t.Format(dateTimeLayout)
+}
@@ -2,6 +2,7 @@ package tasks
 
 import (
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prgrs/clickup/api"
 	"github.com/prgrs/clickup/pkg/clickup"
 )
 
@@ -17,3 +18,23 @@ func LostFocusCmd() tea.Cmd {
 func UpdateTaskCmd(task clickup.Task) tea.Cmd {
 	return func() tea.Msg { return UpdateTaskMsg(task) }
 }
+
+// BulkUpdateMsg is a result of a task updated in bulk. Done is set once all
+// tasks are updated.
+type BulkUpdateMsg struct {
+	updates <-chan api.TaskUpdateResult
+	Result  api.TaskUpdateResult
+	Done    bool
+}
+
+// WaitForBulkUpdateCmd waits for the next task updated in bulk.
+func WaitForBulkUpdateCmd(updates <-chan api.TaskUpdateResult) tea.Cmd {
+	return func() tea.Msg {
+		result, ok := <-updates
+		return BulkUpdateMsg{
+			updates: updates,
+			Result:  result,
+			Done:    !ok,
+		}
+	}
+}
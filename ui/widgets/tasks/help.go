@@ -43,6 +43,12 @@ func (m Model) Help() help.KeyMap {
 						m.keyMap.EditName,
 						m.keyMap.EditStatus,
 						m.keyMap.EditAssigness,
+						m.keyMap.EditPriority,
+						m.keyMap.EditTags,
+						m.keyMap.EditDueDate,
+						m.keyMap.EditStartDate,
+						m.keyMap.EditTimeEstimate,
+						m.keyMap.EditArchive,
 						m.keyMap.EditQuit,
 					},
 				}
@@ -73,6 +79,11 @@ func (m Model) Help() help.KeyMap {
 					m.keyMap.OpenTicketInWebBrowser,
 					m.keyMap.ToggleSidebar,
 					m.keyMap.EditMode,
+					m.keyMap.ToggleArchived,
+					m.keyMap.Archive,
+					m.keyMap.MoveToList,
+					m.keyMap.Undo,
+					m.keyMap.Redo,
 				},
 			)
 		},
@@ -86,3 +97,19 @@ func (m Model) Help() help.KeyMap {
 		},
 	)
 }
+
+// IsFiltering reports whether keys are typed into the filter of the table.
+func (m Model) IsFiltering() bool {
+	return m.state == m.componenetTasksTable.Id() && m.componenetTasksTable.IsFiltering()
+}
+
+func (m Model) Modes() []string {
+	switch {
+	case m.copyMode:
+		return []string{modeCopy}
+	case m.editMode:
+		return []string{modeEdit}
+	default:
+		return []string{string(m.state)}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/prgrs/clickup/ui/common"
+	"github.com/prgrs/clickup/ui/keybindings"
 	"golang.design/x/clipboard"
 )
 
@@ -23,8 +24,19 @@ type KeyMap struct {
 	EditName                    key.Binding
 	EditStatus                  key.Binding
 	EditAssigness               key.Binding
+	EditPriority                key.Binding
+	EditTags                    key.Binding
+	EditDueDate                 key.Binding
+	EditStartDate               key.Binding
+	EditTimeEstimate            key.Binding
+	EditArchive                 key.Binding
 	EditQuit                    key.Binding
 	Refresh                     key.Binding
+	ToggleArchived              key.Binding
+	Archive                     key.Binding
+	MoveToList                  key.Binding
+	Undo                        key.Binding
+	Redo                        key.Binding
 }
 
 func DefaultKeyMap() KeyMap {
@@ -65,6 +77,26 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("R"),
 			key.WithHelp("R", "go to refresh"),
 		),
+		ToggleArchived: key.NewBinding(
+			key.WithKeys("A"),
+			key.WithHelp("A", "toggle archived"),
+		),
+		Archive: key.NewBinding(
+			key.WithKeys("X"),
+			key.WithHelp("X", "archive/unarchive"),
+		),
+		MoveToList: key.NewBinding(
+			key.WithKeys("M"),
+			key.WithHelp("M", "move to list"),
+		),
+		Undo: key.NewBinding(
+			key.WithKeys("z"),
+			key.WithHelp("z", "undo edit"),
+		),
+		Redo: key.NewBinding(
+			key.WithKeys("Z"),
+			key.WithHelp("Z", "redo edit"),
+		),
 		EditMode: key.NewBinding(
 			key.WithKeys("e"),
 			key.WithHelp("e", "edit mode"),
@@ -85,6 +117,30 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("a"),
 			key.WithHelp("a", "edit assigness"),
 		),
+		EditPriority: key.NewBinding(
+			key.WithKeys("P"),
+			key.WithHelp("P", "edit priority"),
+		),
+		EditTags: key.NewBinding(
+			key.WithKeys("t"),
+			key.WithHelp("t", "edit tags"),
+		),
+		EditDueDate: key.NewBinding(
+			key.WithKeys("d"),
+			key.WithHelp("d", "edit due date"),
+		),
+		EditStartDate: key.NewBinding(
+			key.WithKeys("S"),
+			key.WithHelp("S", "edit start date"),
+		),
+		EditTimeEstimate: key.NewBinding(
+			key.WithKeys("E"),
+			key.WithHelp("E", "edit time estimate"),
+		),
+		EditArchive: key.NewBinding(
+			key.WithKeys("X"),
+			key.WithHelp("X", "archive/unarchive"),
+		),
 		EditQuit: key.NewBinding(
 			key.WithKeys("esc"),
 			key.WithHelp("esc", "quit edit mode"),
@@ -92,19 +148,110 @@ func DefaultKeyMap() KeyMap {
 	}
 }
 
+func (km *KeyMap) Bindings() keybindings.Bindings {
+	return keybindings.Bindings{
+		"open_in_browser_batch": &km.OpenTicketInWebBrowserBatch,
+		"open_in_browser":       &km.OpenTicketInWebBrowser,
+		"toggle_sidebar":        &km.ToggleSidebar,
+		"copy_mode":             &km.CopyMode,
+		"lost_focus":            &km.LostFocus,
+		"edit_mode":             &km.EditMode,
+		"refresh":               &km.Refresh,
+		"toggle_archived":       &km.ToggleArchived,
+		"archive":               &km.Archive,
+		"move_to_list":          &km.MoveToList,
+		"undo":                  &km.Undo,
+		"redo":                  &km.Redo,
+	}
+}
+
+func (km *KeyMap) BindingsCopyMode() keybindings.Bindings {
+	return keybindings.Bindings{
+		"copy_task_id":     &km.CopyTaskId,
+		"copy_task_url":    &km.CopyTaskUrl,
+		"copy_task_url_md": &km.CopyTaskUrlMd,
+		"lost_focus":       &km.LostFocus,
+	}
+}
+
+func (km *KeyMap) BindingsEditMode() keybindings.Bindings {
+	return keybindings.Bindings{
+		"edit_description": &km.EditDescription,
+		"edit_name":        &km.EditName,
+		"edit_status":      &km.EditStatus,
+		"edit_assignees":   &km.EditAssigness,
+		"edit_priority":    &km.EditPriority,
+		"edit_tags":        &km.EditTags,
+		"edit_due_date":    &km.EditDueDate,
+		"edit_start_date":  &km.EditStartDate,
+		"edit_estimate":    &km.EditTimeEstimate,
+		"edit_archive":     &km.EditArchive,
+		"edit_quit":        &km.EditQuit,
+	}
+}
+
 func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
-	var cmds []tea.Cmd
+	if m.bulk != nil && m.bulk.done() && key.Matches(msg, m.keyMap.LostFocus) {
+		m.bulk = nil
+		return nil
+	}
 
 	if m.copyMode {
-		return m.handleKeysCopyMode(msg)
+		if action, ok := keybindings.Match(msg, m.keyMap.BindingsCopyMode()); ok {
+			return m.handleAction(action)
+		}
+		return nil
 	}
 
 	if m.editMode {
-		return m.handleKeysEditMode(msg)
+		if action, ok := keybindings.Match(msg, m.keyMap.BindingsEditMode()); ok {
+			return m.handleAction(action)
+		}
+		return nil
+	}
+
+	// The filter takes every key until it is blurred.
+	if m.IsFiltering() {
+		return m.componenetTasksTable.Update(msg)
+	}
+
+	if action, ok := keybindings.Match(msg, m.keyMap.Bindings()); ok {
+		cmd := m.handleAction(action)
+		if action != "lost_focus" {
+			return cmd
+		}
+
+		return tea.Batch(cmd,
+			m.componenetTasksSidebar.Update(msg),
+			m.componenetTasksTable.Update(msg),
+		)
+	}
+
+	var cmd tea.Cmd
+	switch m.state {
+	case m.componenetTasksSidebar.Id():
+		cmd = m.componenetTasksSidebar.Update(msg)
+	case m.componenetTasksTable.Id():
+		cmd = m.componenetTasksTable.Update(msg)
 	}
 
-	switch {
-	case key.Matches(msg, m.keyMap.OpenTicketInWebBrowserBatch):
+	return cmd
+}
+
+// Handlers returns the handlers of the actions of the tasks widget and its
+// components.
+func (m *Model) Handlers() keybindings.Handlers {
+	return keybindings.Handlers{id: m.handleAction}.Merge(
+		m.componenetTasksTable.Handlers(),
+		m.componenetTasksSidebar.Handlers(),
+	)
+}
+
+// handleAction runs the action of the tasks widget by its name. Actions of
+// the copy and the edit mode leave the mode.
+func (m *Model) handleAction(action string) tea.Cmd {
+	switch action {
+	case "open_in_browser_batch":
 		tasks := m.componenetTasksTable.GetSelectedTasks()
 		for _, task := range tasks {
 			m.log.Debug("Opening task in the web browser", "url", task.Url)
@@ -112,40 +259,56 @@ func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
 				m.log.Fatal(err)
 			}
 		}
-		return nil
 
-	case key.Matches(msg, m.keyMap.Refresh):
+	case "refresh":
 		m.log.Info("Refreshing...")
 		if err := m.ctx.Api.Sync(); err != nil {
 			m.log.Error("Failed to sync", "error", err)
 		}
 		m.log.Debug("API sync")
-		return nil
 
-	case key.Matches(msg, m.keyMap.OpenTicketInWebBrowser):
+	case "open_in_browser":
 		task := m.componenetTasksTable.GetHighlightedTask()
 		m.log.Debug("Opening task in the web browser", "url", task.Url)
 		if err := common.OpenUrlInWebBrowser(task.Url); err != nil {
 			m.log.Fatal(err)
 		}
-		return nil
 
-	case key.Matches(msg, m.keyMap.ToggleSidebar):
+	case "toggle_archived":
+		m.showArchived = !m.showArchived
+		m.log.Debug("Toggle archived", "show", m.showArchived)
+		return m.reloadTasks()
+
+	case "archive":
+		return m.bulkArchive()
+
+	case "move_to_list":
+		return m.moveTasks()
+
+	case "undo":
+		return m.revertEdit("Undo", m.ctx.Api.Undo, false)
+
+	case "redo":
+		return m.revertEdit("Redo", m.ctx.Api.Redo, false)
+
+	case "toggle_sidebar":
 		m.log.Debug("Toggle sidebar")
 		m.componenetTasksSidebar.SetHidden(!m.componenetTasksSidebar.GetHidden())
-		return nil
 
-	case key.Matches(msg, m.keyMap.CopyMode):
+	case "copy_mode":
 		m.log.Debug("Toggle copy mode")
 		m.copyMode = true
-		return nil
 
-	case key.Matches(msg, m.keyMap.EditMode):
+	case "edit_mode":
 		m.log.Debug("Toggle edit mode")
 		m.editMode = true
-		return nil
 
-	case key.Matches(msg, m.keyMap.LostFocus):
+	case "lost_focus":
+		if m.copyMode {
+			m.copyMode = false
+			return nil
+		}
+
 		switch m.state {
 		case m.componenetTasksSidebar.Id():
 			m.state = m.componenetTasksTable.Id()
@@ -156,75 +319,75 @@ func (m *Model) handleKeys(msg tea.KeyMsg) tea.Cmd {
 			m.componenetTasksSidebar.SetFocused(false)
 			m.componenetTasksTable.SetFocused(false)
 
-			cmds = append(cmds, LostFocusCmd())
+			return LostFocusCmd()
 		}
 
-		return tea.Batch(append(cmds,
-			m.componenetTasksSidebar.Update(msg),
-			m.componenetTasksTable.Update(msg),
-		)...)
-
-	}
-
-	var cmd tea.Cmd
-	switch m.state {
-	case m.componenetTasksSidebar.Id():
-		cmd = m.componenetTasksSidebar.Update(msg)
-	case m.componenetTasksTable.Id():
-		cmd = m.componenetTasksTable.Update(msg)
-	}
-
-	return tea.Batch(append(cmds, cmd)...)
-}
-
-func (m *Model) handleKeysCopyMode(msg tea.KeyMsg) tea.Cmd {
-	switch {
-	case key.Matches(msg, m.keyMap.CopyTaskId):
+	case "copy_task_id":
 		task := m.componenetTasksTable.GetHighlightedTask()
 		clipboard.Write(clipboard.FmtText, []byte(task.Id))
 		m.copyMode = false
 
-	case key.Matches(msg, m.keyMap.CopyTaskUrl):
+	case "copy_task_url":
 		task := m.componenetTasksTable.GetHighlightedTask()
 		clipboard.Write(clipboard.FmtText, []byte(task.Url))
 		m.copyMode = false
 
-	case key.Matches(msg, m.keyMap.CopyTaskUrlMd):
+	case "copy_task_url_md":
 		task := m.componenetTasksTable.GetHighlightedTask()
 		md := fmt.Sprintf("[[#%s] - %s](%s)", task.Id, task.Name, task.Url)
 		clipboard.Write(clipboard.FmtText, []byte(md))
 		m.copyMode = false
 
-	case key.Matches(msg, m.keyMap.LostFocus):
-		m.copyMode = false
-	}
-
-	return nil
-}
-
-func (m *Model) handleKeysEditMode(msg tea.KeyMsg) tea.Cmd {
-	switch {
-	case key.Matches(msg, m.keyMap.EditDescription):
+	case "edit_description":
 		data := m.componenetTasksSidebar.SelectedTask.MarkdownDescription
 		m.editMode = false
+		m.editBase = m.componenetTasksSidebar.SelectedTask
 		return common.OpenEditor(editorIdDescription, data)
 
-	case key.Matches(msg, m.keyMap.EditName):
+	case "edit_name":
 		data := m.componenetTasksSidebar.SelectedTask.Name
 		m.editMode = false
+		m.editBase = m.componenetTasksSidebar.SelectedTask
 		return common.OpenEditor(editorIdName, data)
 
-	case key.Matches(msg, m.keyMap.EditStatus):
-		data := m.componenetTasksSidebar.SelectedTask.Status.Status
+	case "edit_status":
 		m.editMode = false
+		if len(m.componenetTasksTable.GetSelectedTasks()) > 0 {
+			return m.bulkEditStatus()
+		}
+		data := m.componenetTasksSidebar.SelectedTask.Status.Status
+		m.editBase = m.componenetTasksSidebar.SelectedTask
 		return common.OpenEditor(editorIdStatus, data)
 
-	// case key.Matches(msg, m.keyMap.EditAssigness):
-	// 	data := m.SelectedTask.Assignees
-	// 	cmds = append(cmds, common.OpenEditor(data))
-	// 	m.editMode = false
+	case "edit_assignees":
+		m.editMode = false
+		return m.bulkEditAssignees()
+
+	case "edit_priority":
+		m.editMode = false
+		return m.bulkEditPriority()
+
+	case "edit_tags":
+		m.editMode = false
+		return m.bulkEditTags()
+
+	case "edit_due_date":
+		m.editMode = false
+		return m.bulkEditDueDate()
+
+	case "edit_start_date":
+		m.editMode = false
+		return m.bulkEditStartDate()
+
+	case "edit_estimate":
+		m.editMode = false
+		return m.bulkEditTimeEstimate()
+
+	case "edit_archive":
+		m.editMode = false
+		return m.bulkArchive()
 
-	case key.Matches(msg, m.keyMap.EditQuit):
+	case "edit_quit":
 		m.editMode = false
 	}
 
@@ -1,17 +1,22 @@
 package tasks
 
 import (
+	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/log"
+	"github.com/prgrs/clickup/api"
 	"github.com/prgrs/clickup/pkg/clickup"
+	"github.com/prgrs/clickup/pkg/merge"
 	"github.com/prgrs/clickup/ui/common"
 	tabletasks "github.com/prgrs/clickup/ui/components/table-tasks"
 	taskssidebar "github.com/prgrs/clickup/ui/components/tasks-sidebar"
 	"github.com/prgrs/clickup/ui/context"
+	"github.com/prgrs/clickup/ui/widgets/listpicker"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -21,8 +26,15 @@ const (
 	editorIdDescription = "description"
 	editorIdName        = "name"
 	editorIdStatus      = "status"
+
+	modeCopy = id + "/copy"
+	modeEdit = id + "/edit"
 )
 
+// TasksFetcher returns the tasks to display, either from the cache or synced
+// with the API.
+type TasksFetcher func(cached bool) ([]clickup.Task, error)
+
 type Model struct {
 	log                *log.Logger
 	ctx                *context.UserContext
@@ -36,9 +48,19 @@ type Model struct {
 	spinner            spinner.Model
 	showSpinner        bool
 	SelectedViewListId string
+	// SelectedListId is the list whose archived tasks are shown besides
+	// archived tasks of lists already in the table.
+	SelectedListId string
+	fetcher        TasksFetcher
+	showArchived   bool
 
 	copyMode bool // TODO make as a widget
 	editMode bool
+	bulk     *bulkEdit
+	// editBase is the version of the task the edit in the editor started
+	// from and editField is the field being edited.
+	editBase  clickup.Task
+	editField clickup.TaskField
 
 	componenetTasksTable   *tabletasks.Model
 	componenetTasksSidebar *taskssidebar.Model
@@ -58,7 +80,15 @@ func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
 	var (
 		componenetTasksTable   = tabletasks.InitialModel(ctx, log)
 		componenetTasksSidebar = taskssidebar.InitialModel(ctx, log).WithHidden(true)
+		keyMap                 = DefaultKeyMap()
+	)
+
+	ctx.KeyBindings.Register(id, keyMap.Bindings(),
+		string(componenetTasksTable.Id()),
+		string(componenetTasksSidebar.Id()),
 	)
+	ctx.KeyBindings.Register(id, keyMap.BindingsCopyMode(), modeCopy)
+	ctx.KeyBindings.Register(id, keyMap.BindingsEditMode(), modeEdit)
 
 	return Model{
 		id:                     id,
@@ -66,7 +96,7 @@ func InitialModel(ctx *context.UserContext, logger *log.Logger) Model {
 		size:                   size,
 		Focused:                false,
 		Hidden:                 false,
-		keyMap:                 DefaultKeyMap(),
+		keyMap:                 keyMap,
 		log:                    log,
 		ifBorders:              true,
 		state:                  componenetTasksTable.Id(),
@@ -106,6 +136,15 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			cmds = append(cmds, common.ErrCmd(err))
 		}
 
+	case taskssidebar.OpenTaskMsg:
+		id := string(msg)
+		m.log.Infof("Received: taskssidebar.OpenTaskMsg: %s", id)
+
+		m.componenetTasksTable.HighlightTask(id)
+		if err := m.componenetTasksSidebar.SelectTask(id); err != nil {
+			cmds = append(cmds, common.ErrCmd(err))
+		}
+
 	case common.EditorFinishedMsg:
 		err := msg.Err
 		id := msg.Id
@@ -116,13 +155,16 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		switch id {
 		case editorIdDescription:
 			data := msg.Data.(string)
-			m.componenetTasksSidebar.SelectedTask.Description = data
+			m.componenetTasksSidebar.SelectedTask.MarkdownDescription = data
+			m.editField = clickup.TaskFieldMarkdownDescription
 		case editorIdName:
 			data := msg.Data.(string)
 			m.componenetTasksSidebar.SelectedTask.Name = data
+			m.editField = clickup.TaskFieldName
 		case editorIdStatus:
 			data := msg.Data.(string)
 			m.componenetTasksSidebar.SelectedTask.Status.Status = data
+			m.editField = clickup.TaskFieldStatus
 		}
 
 		cmds = append(cmds, UpdateTaskCmd(m.componenetTasksSidebar.SelectedTask))
@@ -135,9 +177,22 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		tableTasks[m.componenetTasksTable.SelectedIdx] = m.componenetTasksSidebar.SelectedTask
 		m.componenetTasksTable.SetTasks(tableTasks)
 
+	case BulkUpdateMsg:
+		m.log.Debug("Received: BulkUpdateMsg", "done", msg.Done)
+		return m.handleBulkUpdate(msg)
+
 	case UpdateTaskMsg:
 		m.log.Debug("Received: UpdateTaskMsg")
-		t, err := m.ctx.Api.UpdateTask(m.componenetTasksSidebar.SelectedTask)
+		if cmd := m.unresolvedConflict(); cmd != nil {
+			return cmd
+		}
+
+		t, err := m.ctx.Api.UpdateTaskFrom(m.editBase, m.componenetTasksSidebar.SelectedTask, m.editField)
+
+		var conflict api.MergeConflictError
+		if errors.As(err, &conflict) {
+			return m.resolveConflict(conflict)
+		}
 		if err != nil {
 			return common.ErrCmd(err)
 		}
@@ -150,11 +205,13 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		tableTasks[m.componenetTasksTable.SelectedIdx] = m.componenetTasksSidebar.SelectedTask
 		m.componenetTasksTable.SetTasks(tableTasks)
 
-		tasks, err := m.ctx.Api.SyncTasksFromView(m.SelectedViewListId)
+		tasks, err := m.fetchTasks(false)
 		if err != nil {
 			return common.ErrCmd(err)
 		}
-		m.componenetTasksTable.SetTasks(tasks)
+		if tasks != nil {
+			m.componenetTasksTable.SetTasks(tasks)
+		}
 
 	case common.RefreshMsg:
 		m.log.Debug("Received: common.RefreshMsg")
@@ -177,12 +234,11 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		})
 
 		errgroup.Go(func() error {
-			id := m.SelectedViewListId
-			if id != "" {
-				tasks, err := m.ctx.Api.GetTasksFromView(m.SelectedViewListId)
-				if err != nil {
-					return err
-				}
+			tasks, err := m.fetchTasks(true)
+			if err != nil {
+				return err
+			}
+			if tasks != nil {
 				m.componenetTasksTable.SetTasks(tasks)
 			}
 			return nil
@@ -202,8 +258,244 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 	return tea.Batch(cmds...)
 }
 
+// SetFetcher replaces the source of tasks which by default are the tasks of
+// SelectedViewListId.
+func (m *Model) SetFetcher(f TasksFetcher) {
+	m.fetcher = f
+}
+
+func (m *Model) fetchTasks(cached bool) ([]clickup.Task, error) {
+	var (
+		tasks []clickup.Task
+		err   error
+	)
+
+	switch {
+	case m.fetcher != nil:
+		tasks, err = m.fetcher(cached)
+	case m.SelectedViewListId == "":
+		return nil, nil
+	case cached:
+		tasks, err = m.ctx.Api.GetTasksFromView(m.SelectedViewListId)
+	default:
+		tasks, err = m.ctx.Api.SyncTasksFromView(m.SelectedViewListId)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return m.withArchived(tasks, cached)
+}
+
+// withArchived appends archived tasks of the lists the tasks belong to if
+// archived tasks are shown. Views do not return archived tasks so they are
+// fetched from the lists.
+func (m *Model) withArchived(tasks []clickup.Task, cached bool) ([]clickup.Task, error) {
+	if !m.showArchived {
+		return tasks, nil
+	}
+
+	listIds := []string{}
+	if m.SelectedListId != "" {
+		listIds = append(listIds, m.SelectedListId)
+	}
+	for _, t := range tasks {
+		if t.List.Id != "" && !slices.Contains(listIds, t.List.Id) {
+			listIds = append(listIds, t.List.Id)
+		}
+	}
+
+	get := m.ctx.Api.GetArchivedTasksFromList
+	if !cached {
+		get = m.ctx.Api.SyncArchivedTasksFromList
+	}
+
+	result := slices.Clip(tasks)
+	for _, id := range listIds {
+		archived, err := get(id)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range archived {
+			if !slices.ContainsFunc(result, func(r clickup.Task) bool { return r.Id == t.Id }) {
+				result = append(result, t)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// reloadTasks shows tasks fetched again from the cache.
+func (m *Model) reloadTasks() tea.Cmd {
+	tasks, err := m.fetchTasks(true)
+	if err != nil {
+		return common.ErrCmd(err)
+	}
+
+	if tasks != nil {
+		m.componenetTasksTable.SetTasks(tasks)
+	}
+
+	return nil
+}
+
+// targetTasks returns selected tasks or the highlighted one if none is
+// selected.
+func (m *Model) targetTasks() []clickup.Task {
+	ids := []string{}
+	for _, t := range m.componenetTasksTable.GetSelectedTasks() {
+		ids = append(ids, t.Id)
+	}
+
+	if len(ids) == 0 {
+		task := m.componenetTasksTable.GetHighlightedTask()
+		if task == nil {
+			return nil
+		}
+		ids = append(ids, task.Id)
+	}
+
+	result := []clickup.Task{}
+	tasks := m.componenetTasksTable.GetTasks()
+	for _, id := range ids {
+		i := slices.IndexFunc(tasks, func(t clickup.Task) bool { return t.Id == id })
+		if i < 0 {
+			continue
+		}
+		result = append(result, tasks[i])
+	}
+
+	return result
+}
+
+// revertEdit undoes or redoes the last task edit. If the task was changed
+// since the edit it asks for a confirmation first.
+func (m *Model) revertEdit(action string, revert func(force bool) (clickup.Task, error), force bool) tea.Cmd {
+	task, err := revert(force)
+
+	var conflict api.ConflictError
+	switch {
+	case errors.Is(err, api.ErrNothingToUndo), errors.Is(err, api.ErrNothingToRedo):
+		m.log.Info(err.Error())
+		return nil
+
+	case errors.As(err, &conflict):
+		m.log.Warn("Task changed since the edit", "id", conflict.Task.Id)
+		title := fmt.Sprintf("Task %q was changed since the edit. %s anyway?", conflict.Task.Name, action)
+		return common.ConfirmCmd(title, func() tea.Cmd {
+			return m.revertEdit(action, revert, true)
+		})
+
+	case err != nil:
+		return common.ErrCmd(err)
+	}
+
+	m.log.Info(action+" task edit", "id", task.Id)
+
+	if m.componenetTasksSidebar.SelectedTask.Id == task.Id {
+		if err := m.componenetTasksSidebar.SetTask(task); err != nil {
+			return common.ErrCmd(err)
+		}
+	}
+
+	return m.reloadTasks()
+}
+
+// resolveConflict shows the latest version of the task and asks to resolve
+// the conflicting field in the editor. Declining it drops the edit.
+func (m *Model) resolveConflict(conflict api.MergeConflictError) tea.Cmd {
+	m.log.Warn("Edit conflicts with a remote change", "id", conflict.Latest.Id, "field", conflict.Field)
+
+	if err := m.componenetTasksSidebar.SetTask(conflict.Latest); err != nil {
+		return common.ErrCmd(err)
+	}
+
+	title := fmt.Sprintf("%s Resolve the conflict in the editor? Declining drops your edit.", conflict.Error())
+
+	return common.ConfirmCmd(title, func() tea.Cmd {
+		m.editBase = conflict.Latest
+		m.editField = conflict.Field
+		m.componenetTasksSidebar.SelectedTask = conflict.Merged
+
+		id, data, _ := editedText(conflict.Merged, conflict.Field)
+		return common.OpenEditor(id, data)
+	})
+}
+
+// unresolvedConflict refuses to save the edit while conflict markers remain
+// in the edited field and asks to resolve them in the editor again.
+// Declining it drops the edit.
+func (m *Model) unresolvedConflict() tea.Cmd {
+	edit := m.componenetTasksSidebar.SelectedTask
+
+	id, data, ok := editedText(edit, m.editField)
+	if !ok || !merge.HasConflicts(data) {
+		return nil
+	}
+
+	m.log.Warn("Edit still contains conflict markers", "id", edit.Id, "field", m.editField)
+
+	if err := m.componenetTasksSidebar.SetTask(m.editBase); err != nil {
+		return common.ErrCmd(err)
+	}
+
+	title := fmt.Sprintf("The %s still contains conflict markers and cannot be saved. Resolve them in the editor? Declining drops your edit.", id)
+
+	return common.ConfirmCmd(title, func() tea.Cmd {
+		m.componenetTasksSidebar.SelectedTask = edit
+		return common.OpenEditor(id, data)
+	})
+}
+
+// editedText returns the editor and the text of a field edited in it as text
+// which may hold conflict markers.
+func editedText(task clickup.Task, field clickup.TaskField) (string, string, bool) {
+	switch field {
+	case clickup.TaskFieldName:
+		return editorIdName, task.Name, true
+	case clickup.TaskFieldMarkdownDescription:
+		return editorIdDescription, task.MarkdownDescription, true
+	}
+
+	return "", "", false
+}
+
+// moveTasks opens the list picker and moves selected tasks or the highlighted
+// one to the picked list in the background. The moves can be undone and
+// failures are listed in the summary.
+func (m *Model) moveTasks() tea.Cmd {
+	tasks := m.targetTasks()
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	return listpicker.OpenCmd(listpicker.OpenMsg{
+		Title:  "Move " + tasksLabel(tasks) + " to list",
+		TeamId: m.teamIdOf(tasks),
+		OnPick: func(list clickup.List) tea.Cmd {
+			tasks := slices.DeleteFunc(slices.Clone(tasks), func(t clickup.Task) bool {
+				return t.List.Id == list.Id
+			})
+
+			title := fmt.Sprintf("Move %s to %q", tasksLabel(tasks), list.Name)
+			return m.startBulkEdit(title, tasks, clickup.TaskFieldList, func(task *clickup.Task) {
+				task.List = clickup.TaskList{Id: list.Id, Name: list.Name}
+			})
+		},
+	})
+}
+
 func (m *Model) SetTasks(tasks []clickup.Task) {
 	m.showSpinner = false
+
+	if t, err := m.withArchived(tasks, true); err != nil {
+		m.log.Error("Failed to fetch archived tasks", "error", err)
+	} else {
+		tasks = t
+	}
 	m.componenetTasksTable.SetTasks(tasks)
 
 	if len(tasks) == 0 {
@@ -219,6 +511,17 @@ func (m *Model) SetTasks(tasks []clickup.Task) {
 }
 
 func (m Model) View() string {
+	if m.bulk == nil {
+		return m.viewTasks()
+	}
+
+	bulk := m.viewBulk(m.size.Width)
+	m.size.Height -= lipgloss.Height(bulk)
+
+	return lipgloss.JoinVertical(lipgloss.Left, m.viewTasks(), bulk)
+}
+
+func (m Model) viewTasks() string {
 	bColor := m.ctx.Theme.BordersColorInactive
 	if m.Focused {
 		bColor = m.ctx.Theme.BordersColorActive